@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/config"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/events"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+	"github.com/justIGreK/MoneyKeeper-Budget/pkg/client"
+)
+
+const adminUsage = `usage:
+  budget [-config FILE] backup -user ID [-out FILE]
+  budget [-config FILE] restore -user ID [-mode fresh|merge] [-in FILE]`
+
+// runAdmin runs an admin command against the configured storage instead of
+// starting the server. Backups are written to stdout and read from stdin
+// unless a file is given.
+func runAdmin(ctx context.Context, cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	userID := flags.String("user", "", "ID of the user")
+	var file, mode *string
+	switch args[0] {
+	case "backup":
+		file = flags.String("out", "", "file to write the backup to")
+	case "restore":
+		file = flags.String("in", "", "file to read the backup from")
+		mode = flags.String("mode", models.RestoreFresh, "fresh keeps the IDs of an empty account, merge assigns new ones")
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], adminUsage)
+	}
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *userID == "" {
+		return fmt.Errorf("-user is required\n%s", adminUsage)
+	}
+
+	userCreds, err := cfg.UserService.TLS.Credentials()
+	if err != nil {
+		return err
+	}
+	user, err := client.NewUserClient(cfg.UserService.Addr, userCreds, cfg.UserService.Timeout)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+	storage := newStorage(ctx, cfg)
+	defer storage.close()
+	rates, err := newRateProvider(cfg.Exchange)
+	if err != nil {
+		return err
+	}
+	// events are written to the outbox and published by the running server
+	broker := events.NewBroker(cfg.Events.WatchHistory)
+	budgetSRV := service.NewBudgetService(storage.budgets, storage.expenses, storage.templates, storage.audit, storage.outbox, storage.tx, broker, storage.locks, user, rates, cfg.Exchange.DefaultCurrency)
+
+	if args[0] == "backup" {
+		backup, err := budgetSRV.BackupUserData(ctx, *userID)
+		if err != nil {
+			return err
+		}
+		if *file == "" {
+			_, err = os.Stdout.Write(append(backup, '\n'))
+			return err
+		}
+		return os.WriteFile(*file, backup, 0o600)
+	}
+
+	var data []byte
+	if *file == "" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(*file)
+	}
+	if err != nil {
+		return err
+	}
+	result, err := budgetSRV.RestoreUserData(ctx, models.RestoreUserData{UserID: *userID, Data: data, Mode: *mode})
+	if err != nil {
+		return err
+	}
+	log.Printf("restored %d budgets for user %s", len(result.BudgetIDs), *userID)
+	// a merge prints the new ID of every budget
+	oldIDs := slices.Sorted(maps.Keys(result.BudgetIDs))
+	for _, oldID := range oldIDs {
+		if newID := result.BudgetIDs[oldID]; newID != oldID {
+			fmt.Printf("%s %s\n", oldID, newID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) GetBudgetHistory(ctx context.Context, req *budgetProto.GetBudgetHistoryRequest) (*budgetProto.GetBudgetHistoryResponse, error) {
+	history := models.GetBudgetHistory{
+		UserID:    req.UserId,
+		BudgetID:  req.BudgetId,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}
+	if err := validate.Struct(history); err != nil {
+		return nil, err
+	}
+	entries, next, err := s.BudgetSRV.GetBudgetHistory(ctx, history)
+	if err != nil {
+		return nil, err
+	}
+	protoEntries := make([]*budgetProto.AuditEntry, len(entries))
+	for i, entry := range entries {
+		changes := make([]*budgetProto.FieldChange, len(entry.Changes))
+		for j, change := range entry.Changes {
+			changes[j] = &budgetProto.FieldChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+		protoEntries[i] = &budgetProto.AuditEntry{
+			EntryId:   entry.ID,
+			BudgetId:  entry.BudgetID,
+			Actor:     entry.Actor,
+			Rpc:       entry.RPC,
+			RequestId: entry.RequestID,
+			Time:      entry.Time.UTC().Format(time.RFC3339Nano),
+			Changes:   changes,
+		}
+	}
+	return &budgetProto.GetBudgetHistoryResponse{
+		Entries:       protoEntries,
+		NextPageToken: next,
+	}, nil
+}
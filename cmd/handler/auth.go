@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/auth"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/requestinfo"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const authorizationHeader = "authorization"
+
+// UnaryAuthInterceptor authenticates BudgetService calls with the bearer
+// token of the authorization metadata. The caller is recorded as the actor
+// of the audit log and fills in userId fields left empty; naming another
+// user takes the admin role. Other services, such as health checks, are
+// left open.
+func UnaryAuthInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !isBudgetMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, identity, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		if msg, ok := req.(proto.Message); ok {
+			if err := authorizeUser(msg.ProtoReflect(), identity); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor does the same for streaming calls, checking every
+// message the client sends.
+func StreamAuthInterceptor(verifier *auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isBudgetMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, identity, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx, identity: identity})
+	}
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	identity auth.Identity
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return authorizeUser(msg.ProtoReflect(), s.identity)
+	}
+	return nil
+}
+
+func isBudgetMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+budgetProto.BudgetService_ServiceDesc.ServiceName+"/")
+}
+
+func authenticate(ctx context.Context, verifier *auth.Verifier) (context.Context, auth.Identity, error) {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			header = values[0]
+		}
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return ctx, auth.Identity{}, apperrors.Unauthenticated("bearer token is required")
+	}
+	identity, err := verifier.Verify(token)
+	if err != nil {
+		return ctx, auth.Identity{}, err
+	}
+	info := requestinfo.FromContext(ctx)
+	info.Actor = identity.UserID
+	ctx = requestinfo.NewContext(ctx, info)
+	return auth.NewContext(ctx, identity), identity, nil
+}
+
+// authorizeUser checks the userId fields of a request, including those of
+// nested messages such as UpdateBudgetRequest.update, against the caller.
+func authorizeUser(msg protoreflect.Message, identity auth.Identity) error {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.IsList() || field.IsMap() {
+			continue
+		}
+		switch {
+		case field.JSONName() == "userId" && field.Kind() == protoreflect.StringKind:
+			userID := msg.Get(field).String()
+			if userID == "" {
+				msg.Set(field, protoreflect.ValueOfString(identity.UserID))
+			} else if userID != identity.UserID && !identity.Admin {
+				return apperrors.PermissionDenied("not allowed to act for user %s", userID)
+			}
+		case field.Kind() == protoreflect.MessageKind && msg.Has(field):
+			if err := authorizeUser(msg.Get(field).Message(), identity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
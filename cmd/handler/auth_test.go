@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/auth"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/requestinfo"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func testVerifier() *auth.Verifier {
+	return auth.NewVerifier(map[string][]byte{"k1": testKey}, "", "", "admin")
+}
+
+func testToken(t *testing.T, subject string, roles ...string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{Roles: roles, RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}).SignedString(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func withAuthorization(header string) context.Context {
+	if header == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, header))
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		header string
+		req    proto.Message
+		want   proto.Message
+		err    error
+	}{
+		{
+			name:   "other service is left open",
+			method: "/grpc.health.v1.Health/Check",
+			req:    &budgetProto.GetBudgetRequest{},
+			want:   &budgetProto.GetBudgetRequest{},
+		},
+		{
+			name:   "missing token",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			req:    &budgetProto.GetBudgetRequest{UserId: "u1"},
+			err:    apperrors.ErrUnauthenticated,
+		},
+		{
+			name:   "other scheme",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			header: "Basic " + testToken(t, "u1"),
+			req:    &budgetProto.GetBudgetRequest{UserId: "u1"},
+			err:    apperrors.ErrUnauthenticated,
+		},
+		{
+			name:   "invalid token",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			header: "Bearer garbage",
+			req:    &budgetProto.GetBudgetRequest{UserId: "u1"},
+			err:    apperrors.ErrUnauthenticated,
+		},
+		{
+			name:   "empty userId is filled",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			header: "Bearer " + testToken(t, "u1"),
+			req:    &budgetProto.GetBudgetRequest{BudgetId: "b1"},
+			want:   &budgetProto.GetBudgetRequest{BudgetId: "b1", UserId: "u1"},
+		},
+		{
+			name:   "own userId",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			header: "bearer " + testToken(t, "u1"),
+			req:    &budgetProto.GetBudgetRequest{UserId: "u1"},
+			want:   &budgetProto.GetBudgetRequest{UserId: "u1"},
+		},
+		{
+			name:   "other userId",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			header: "Bearer " + testToken(t, "u1"),
+			req:    &budgetProto.GetBudgetRequest{UserId: "u2"},
+			err:    apperrors.ErrPermissionDenied,
+		},
+		{
+			name:   "other userId as admin",
+			method: budgetProto.BudgetService_GetBudget_FullMethodName,
+			header: "Bearer " + testToken(t, "u1", "admin"),
+			req:    &budgetProto.GetBudgetRequest{UserId: "u2"},
+			want:   &budgetProto.GetBudgetRequest{UserId: "u2"},
+		},
+		{
+			name:   "nested empty userId is filled",
+			method: budgetProto.BudgetService_UpdateBudget_FullMethodName,
+			header: "Bearer " + testToken(t, "u1"),
+			req:    &budgetProto.UpdateBudgetRequest{Update: &budgetProto.UpdateBudget{BudgetId: "b1"}},
+			want:   &budgetProto.UpdateBudgetRequest{Update: &budgetProto.UpdateBudget{BudgetId: "b1", UserId: "u1"}},
+		},
+		{
+			name:   "nested other userId",
+			method: budgetProto.BudgetService_UpdateBudget_FullMethodName,
+			header: "Bearer " + testToken(t, "u1"),
+			req:    &budgetProto.UpdateBudgetRequest{Update: &budgetProto.UpdateBudget{UserId: "u2"}},
+			err:    apperrors.ErrPermissionDenied,
+		},
+	}
+	interceptor := UnaryAuthInterceptor(testVerifier())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled proto.Message
+			_, err := interceptor(withAuthorization(tt.header), tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req any) (any, error) {
+					handled = req.(proto.Message)
+					return nil, nil
+				})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if handled != nil {
+					t.Fatal("handler was called")
+				}
+				return
+			}
+			if !proto.Equal(handled, tt.want) {
+				t.Fatalf("handled %v, want %v", handled, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnaryAuthInterceptorActor(t *testing.T) {
+	interceptor := UnaryAuthInterceptor(testVerifier())
+	var actor string
+	var identity auth.Identity
+	_, err := interceptor(withAuthorization("Bearer "+testToken(t, "u1", "admin")), &budgetProto.GetBudgetRequest{},
+		&grpc.UnaryServerInfo{FullMethod: budgetProto.BudgetService_GetBudget_FullMethodName},
+		func(ctx context.Context, req any) (any, error) {
+			actor = requestinfo.FromContext(ctx).Actor
+			identity, _ = auth.FromContext(ctx)
+			return nil, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actor != "u1" || identity != (auth.Identity{UserID: "u1", Admin: true}) {
+		t.Fatalf("actor %q, identity %+v", actor, identity)
+	}
+}
+
+type recvStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs []proto.Message
+}
+
+func (s *recvStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recvStream) RecvMsg(m any) error {
+	if len(s.msgs) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.msgs[0])
+	s.msgs = s.msgs[1:]
+	return nil
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		msgs   []proto.Message
+		want   []string
+		err    error
+	}{
+		{
+			name: "missing token",
+			msgs: []proto.Message{&budgetProto.ImportBudgetsRequest{UserId: "u1"}},
+			err:  apperrors.ErrUnauthenticated,
+		},
+		{
+			name:   "every message is checked",
+			header: "Bearer " + testToken(t, "u1"),
+			msgs:   []proto.Message{&budgetProto.ImportBudgetsRequest{}, &budgetProto.ImportBudgetsRequest{UserId: "u1"}},
+			want:   []string{"u1", "u1"},
+		},
+		{
+			name:   "later message names another user",
+			header: "Bearer " + testToken(t, "u1"),
+			msgs:   []proto.Message{&budgetProto.ImportBudgetsRequest{UserId: "u1"}, &budgetProto.ImportBudgetsRequest{UserId: "u2"}},
+			want:   []string{"u1"},
+			err:    apperrors.ErrPermissionDenied,
+		},
+		{
+			name:   "admin acts for another user",
+			header: "Bearer " + testToken(t, "u1", "admin"),
+			msgs:   []proto.Message{&budgetProto.ImportBudgetsRequest{UserId: "u2"}},
+			want:   []string{"u2"},
+		},
+	}
+	interceptor := StreamAuthInterceptor(testVerifier())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received []string
+			stream := &recvStream{ctx: withAuthorization(tt.header), msgs: tt.msgs}
+			err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: budgetProto.BudgetService_ImportBudgets_FullMethodName},
+				func(srv any, ss grpc.ServerStream) error {
+					for {
+						var req budgetProto.ImportBudgetsRequest
+						if err := ss.RecvMsg(&req); err == io.EOF {
+							return nil
+						} else if err != nil {
+							return err
+						}
+						received = append(received, req.UserId)
+					}
+				})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+			if !slices.Equal(received, tt.want) {
+				t.Fatalf("received %v, want %v", received, tt.want)
+			}
+		})
+	}
+}
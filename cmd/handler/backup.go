@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) BackupUserData(ctx context.Context, req *budgetProto.BackupUserDataRequest) (*budgetProto.BackupUserDataResponse, error) {
+	backup, err := s.BudgetSRV.BackupUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.BackupUserDataResponse{
+		Backup: string(backup),
+	}, nil
+}
+
+func (s *BudgetServiceServer) RestoreUserData(ctx context.Context, req *budgetProto.RestoreUserDataRequest) (*budgetProto.RestoreUserDataResponse, error) {
+	restore := models.RestoreUserData{
+		UserID: req.UserId,
+		Data:   []byte(req.Backup),
+		Mode:   req.Mode,
+	}
+	if err := validate.Struct(restore); err != nil {
+		return nil, err
+	}
+	result, err := s.BudgetSRV.RestoreUserData(ctx, restore)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.RestoreUserDataResponse{
+		BudgetIds: result.BudgetIDs,
+	}, nil
+}
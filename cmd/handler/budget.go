@@ -2,12 +2,15 @@ package handler
 
 import (
 	"context"
-	"errors"
+	"io"
+	"time"
 
 	"github.com/go-playground/validator"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
 	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
 	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type BudgetServiceServer struct {
@@ -18,30 +21,70 @@ type BudgetServiceServer struct {
 type BudgetService interface {
 	AddBudget(ctx context.Context, budget models.CreateBudget) (string, error)
 	GetBudget(ctx context.Context, userID, budgetID string) (*models.Budget, error)
-	GetBudgetList(ctx context.Context, userID string) ([]models.Budget, error)
-	AddCategory(ctx context.Context, categ models.CreateCategory) (*models.Budget, error)
-	DeleteCategory(ctx context.Context, userID, budgetID, categoryId string) error
-	DeleteBudget(ctx context.Context, userID, budgetID string) error
-	UpdateBudget(ctx context.Context, update models.GetUpdateBudget) (*models.Budget, error)
-	UpdateCategory(ctx context.Context, update models.GetUpdateCategory) (*models.Budget, error)
+	GetBudgetList(ctx context.Context, list models.ListBudgets) ([]models.Budget, string, error)
+	GetBudgetSummary(ctx context.Context, userID, budgetID string) (*models.BudgetSummary, error)
+	AddCategory(ctx context.Context, categ models.CreateCategory) (*models.Budget, []string, error)
+	DeleteCategory(ctx context.Context, userID, budgetID, categoryId string, version *int64) error
+	DeleteBudget(ctx context.Context, userID, budgetID string, version *int64) error
+	UpdateBudget(ctx context.Context, update models.GetUpdateBudget) (*models.Budget, []string, error)
+	UpdateCategory(ctx context.Context, update models.GetUpdateCategory) (*models.Budget, []string, error)
+	AddExpense(ctx context.Context, expense models.CreateExpense) (string, error)
+	ListExpenses(ctx context.Context, userID, budgetID, categoryID string) ([]models.Expense, error)
+	DeleteExpense(ctx context.Context, userID, expenseID string) error
+	ListBudgetSeries(ctx context.Context, userID, seriesID string) ([]models.Budget, error)
+	StopRecurrence(ctx context.Context, userID, budgetID string, version *int64) (*models.Budget, error)
+	ListDeleted(ctx context.Context, userID string) ([]models.Budget, []models.DeletedCategory, error)
+	RestoreBudget(ctx context.Context, userID, budgetID string, version *int64) (*models.Budget, error)
+	RestoreCategory(ctx context.Context, userID, budgetID, categoryID string, version *int64) (*models.Budget, []string, error)
+	GetBudgetHistory(ctx context.Context, req models.GetBudgetHistory) ([]models.AuditEntry, string, error)
+	WatchBudgets(ctx context.Context, userID, resumeToken string, send func(event models.Event, resumeToken string) error) error
+	ShareBudget(ctx context.Context, share models.ShareBudget) (*models.Budget, error)
+	RevokeShare(ctx context.Context, userID, budgetID, memberID string, version *int64) error
+	ListMembers(ctx context.Context, userID, budgetID string) ([]models.Member, error)
+	BackupUserData(ctx context.Context, userID string) ([]byte, error)
+	RestoreUserData(ctx context.Context, restore models.RestoreUserData) (*models.RestoreResult, error)
+	ExportBudgets(ctx context.Context, userID string, w io.Writer) error
+	ImportBudgets(ctx context.Context, userID string, r io.Reader, dryRun bool) (*models.ImportResult, error)
+	CloneBudget(ctx context.Context, clone models.CloneBudget) (*models.Budget, []string, error)
+	CreateTemplate(ctx context.Context, create models.CreateTemplate) (*models.Template, error)
+	GetTemplate(ctx context.Context, userID, templateID string) (*models.Template, error)
+	ListTemplates(ctx context.Context, userID string) ([]models.Template, error)
+	UpdateTemplate(ctx context.Context, update models.UpdateTemplate) (*models.Template, error)
+	DeleteTemplate(ctx context.Context, userID, templateID string) error
+	SaveBudgetAsTemplate(ctx context.Context, save models.SaveBudgetAsTemplate) (*models.Template, error)
+	AddBudgetFromTemplate(ctx context.Context, add models.AddBudgetFromTemplate) (*models.Budget, []string, error)
 }
 
 var validate = validator.New()
 
+func optionalVersion(version *wrapperspb.Int64Value) *int64 {
+	if version == nil {
+		return nil
+	}
+	return &version.Value
+}
+
 func (s *BudgetServiceServer) AddBudget(ctx context.Context, req *budgetProto.AddBudgetRequest) (*budgetProto.AddBudgetResponse, error) {
+	limit, err := fromProtoMoney("limit", req.Limit)
+	if err != nil {
+		return nil, err
+	}
 	createBudget := models.CreateBudget{
-		UserID:    req.UserId,
-		Name:      req.Name,
-		Limit:     float64(req.Limit),
-		Period:    req.Period,
-		StartDate: req.Start,
-		EndDate:   req.End,
+		UserID:     req.UserId,
+		Name:       req.Name,
+		Limit:      limit,
+		Currency:   req.Currency,
+		Allocation: req.Allocation,
+		Period:     req.Period,
+		StartDate:  req.Start,
+		EndDate:    req.End,
+		Recurring:  req.Recurring,
 	}
 	if err := validate.Struct(createBudget); err != nil {
 		return nil, err
 	}
 	if createBudget.Period == "" && (createBudget.StartDate == "" && createBudget.EndDate == "") {
-		return nil, errors.New("missing agruments: period")
+		return nil, apperrors.InvalidArgument("missing arguments: period")
 	}
 	budgetID, err := s.BudgetSRV.AddBudget(ctx, createBudget)
 	if err != nil {
@@ -54,35 +97,43 @@ func (s *BudgetServiceServer) AddBudget(ctx context.Context, req *budgetProto.Ad
 }
 
 func (s *BudgetServiceServer) AddCategory(ctx context.Context, req *budgetProto.AddCategoryRequest) (*budgetProto.GetBudgetResponse, error) {
+	limit, err := fromProtoMoney("limit", req.Limit)
+	if err != nil {
+		return nil, err
+	}
 	addCategory := models.CreateCategory{
 		UserID:   req.UserId,
 		BudgetID: req.BudgetId,
 		Name:     req.Name,
-		Limit:    float64(req.Limit),
+		Limit:    limit,
+		Version:  optionalVersion(req.Version),
+	}
+	if req.RolloverMode != "" {
+		rolloverCap, err := fromProtoMoney("rolloverCap", req.RolloverCap)
+		if err != nil {
+			return nil, err
+		}
+		addCategory.Rollover = &models.Rollover{
+			Mode: req.RolloverMode,
+			Cap:  rolloverCap,
+		}
 	}
 	if err := validate.Struct(addCategory); err != nil {
 		return nil, err
 	}
-	budget, err := s.BudgetSRV.AddCategory(ctx, addCategory)
+	budget, warnings, err := s.BudgetSRV.AddCategory(ctx, addCategory)
 	if err != nil {
 		return nil, err
 	}
-	protoBudget := budgetProto.Budget{
-		BudgetId: budget.ID,
-		Name:     budget.Name,
-		Limit:    float32(budget.Limit),
-		Start:    budget.StartDate.Format(Dateformat),
-		End:      budget.EndDate.Format(Dateformat),
-		Category: convertToProtoCategories(budget.Category),
-	}
 	return &budgetProto.GetBudgetResponse{
-		Budget: &protoBudget,
+		Budget:   convertToProtoBudget(budget, req.UserId),
+		Warnings: warnings,
 	}, nil
 
 }
 
 func (s *BudgetServiceServer) DeleteCategory(ctx context.Context, req *budgetProto.DeleteCategoryRequest) (*emptypb.Empty, error) {
-	err := s.BudgetSRV.DeleteCategory(ctx, req.UserId, req.BudgetId, req.CategoryId)
+	err := s.BudgetSRV.DeleteCategory(ctx, req.UserId, req.BudgetId, req.CategoryId, optionalVersion(req.Version))
 	if err != nil {
 		return &emptypb.Empty{}, err
 	}
@@ -90,7 +141,7 @@ func (s *BudgetServiceServer) DeleteCategory(ctx context.Context, req *budgetPro
 }
 
 func (s *BudgetServiceServer) DeleteBudget(ctx context.Context, req *budgetProto.DeleteBudgetRequest) (*emptypb.Empty, error) {
-	err := s.BudgetSRV.DeleteBudget(ctx, req.UserId, req.BudgetId)
+	err := s.BudgetSRV.DeleteBudget(ctx, req.UserId, req.BudgetId, optionalVersion(req.Version))
 	if err != nil {
 		return &emptypb.Empty{}, err
 	}
@@ -101,6 +152,7 @@ func (s *BudgetServiceServer) UpdateBudget(ctx context.Context, req *budgetProto
 	updateBudget := models.GetUpdateBudget{
 		BudgetID: req.Update.BudgetId,
 		UserID:   req.Update.UserId,
+		Version:  optionalVersion(req.Update.Version),
 	}
 	if err := validate.Struct(updateBudget); err != nil {
 		return nil, err
@@ -111,43 +163,42 @@ func (s *BudgetServiceServer) UpdateBudget(ctx context.Context, req *budgetProto
 	if req.Update.Name != nil {
 		updateBudget.Name = &req.Update.Name.Value 
 	}
-	if req.Update.Limit != nil {
-		updateBudget.Limit = &req.Update.Limit.Value 
+	limit, err := optionalMoney("limit", req.Update.Limit)
+	if err != nil {
+		return nil, err
 	}
+	updateBudget.Limit = limit
 	if req.Update.Start != nil {
 		updateBudget.Start = &req.Update.Start.Value 
 	}
 	if req.Update.End != nil {
 		updateBudget.End = &req.Update.End.Value 
 	}
+	if req.Update.Allocation != nil {
+		updateBudget.Allocation = &req.Update.Allocation.Value
+	}
 	
-	budget, err := s.BudgetSRV.UpdateBudget(ctx, updateBudget)
+	budget, warnings, err := s.BudgetSRV.UpdateBudget(ctx, updateBudget)
 	if err != nil {
 		return nil, err
 	}
-	protoBudget := budgetProto.Budget{
-		BudgetId: budget.ID,
-		Name:     budget.Name,
-		Limit:    float32(budget.Limit),
-		Start:    budget.StartDate.Format(Dateformat),
-		End:      budget.EndDate.Format(Dateformat),
-		Category: convertToProtoCategories(budget.Category),
-	}
 	return &budgetProto.GetBudgetResponse{
-		Budget: &protoBudget,
+		Budget:   convertToProtoBudget(budget, req.Update.UserId),
+		Warnings: warnings,
 	}, nil
 }
 func (s *BudgetServiceServer)validateUpdateBudget(req *budgetProto.UpdateBudgetRequest) error {
 	if req.Update.Name == nil && req.Update.Limit == nil &&
-	req.Update.Start == nil && req.Update.End == nil{
-		return errors.New("no new updates")
+		req.Update.Start == nil && req.Update.End == nil && req.Update.Allocation == nil {
+		return apperrors.InvalidArgument("no new updates")
 	}
 	return nil
 }
 
 func (s *BudgetServiceServer)validateUpdateCategory(req *budgetProto.UpdateCategoryRequest) error {
-	if req.Update.Name == nil && req.Update.Limit == nil {
-		return errors.New("either 'Name' or 'Limit' must be provided")
+	if req.Update.Name == nil && req.Update.Limit == nil &&
+		req.Update.RolloverMode == nil && req.Update.RolloverCap == nil {
+		return apperrors.InvalidArgument("either 'Name', 'Limit' or rollover settings must be provided")
 	}
 	return nil
 }
@@ -158,6 +209,7 @@ func (s *BudgetServiceServer) UpdateCategory(ctx context.Context, req *budgetPro
 		BudgetID:   req.Update.BudgetId,
 		UserID:     req.Update.UserId,
 		CategoryID: req.Update.CategoryId,
+		Version:    optionalVersion(req.Update.Version),
 	}
 	if err := validate.Struct(updateCategory); err != nil {
 		return nil, err
@@ -168,24 +220,27 @@ func (s *BudgetServiceServer) UpdateCategory(ctx context.Context, req *budgetPro
 	if req.Update.Name != nil {
 		updateCategory.Name = &req.Update.Name.Value 
 	}
-	if req.Update.Limit != nil {
-		updateCategory.Limit = &req.Update.Limit.Value 
+	limit, err := optionalMoney("limit", req.Update.Limit)
+	if err != nil {
+		return nil, err
+	}
+	updateCategory.Limit = limit
+	if req.Update.RolloverMode != nil {
+		updateCategory.RolloverMode = &req.Update.RolloverMode.Value
 	}
-	budget, err := s.BudgetSRV.UpdateCategory(ctx, updateCategory)
+	rolloverCap, err := optionalMoney("rolloverCap", req.Update.RolloverCap)
 	if err != nil {
 		return nil, err
 	}
-	
-	protoBudget := budgetProto.Budget{
-		BudgetId: budget.ID,
-		Name:     budget.Name,
-		Limit:    float32(budget.Limit),
-		Start:    budget.StartDate.Format(Dateformat),
-		End:      budget.EndDate.Format(Dateformat),
-		Category: convertToProtoCategories(budget.Category),
+	updateCategory.RolloverCap = rolloverCap
+	budget, warnings, err := s.BudgetSRV.UpdateCategory(ctx, updateCategory)
+	if err != nil {
+		return nil, err
 	}
+	
 	return &budgetProto.GetBudgetResponse{
-		Budget: &protoBudget,
+		Budget:   convertToProtoBudget(budget, req.Update.UserId),
+		Warnings: warnings,
 	}, nil
 }
 
@@ -194,28 +249,45 @@ func (s *BudgetServiceServer) GetBudget(ctx context.Context, req *budgetProto.Ge
 	if err != nil {
 		return nil, err
 	}
-	protoBudget := budgetProto.Budget{
-		BudgetId: budget.ID,
-		Name:     budget.Name,
-		Limit:    float32(budget.Limit),
-		Start:    budget.StartDate.Format(Dateformat),
-		End:      budget.EndDate.Format(Dateformat),
-		Category: convertToProtoCategories(budget.Category),
-	}
 	return &budgetProto.GetBudgetResponse{
-		Budget: &protoBudget,
+		Budget: convertToProtoBudget(budget, req.UserId),
 	}, nil
 }
 
-func (s *BudgetServiceServer) GetBudgetList(ctx context.Context, req *budgetProto.GetBudgetListRequest) (*budgetProto.GetBudgetListResponse, error) {
+func (s *BudgetServiceServer) GetBudgetSummary(ctx context.Context, req *budgetProto.GetBudgetRequest) (*budgetProto.GetBudgetSummaryResponse, error) {
+	summary, err := s.BudgetSRV.GetBudgetSummary(ctx, req.UserId, req.BudgetId)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetSummaryResponse{
+		Summary: convertToProtoSummary(summary),
+	}, nil
+}
 
-	budgets, err := s.BudgetSRV.GetBudgetList(ctx, req.UserId)
+func (s *BudgetServiceServer) GetBudgetList(ctx context.Context, req *budgetProto.GetBudgetListRequest) (*budgetProto.GetBudgetListResponse, error) {
+	list := models.ListBudgets{
+		UserID:     req.UserId,
+		ActiveAt:   req.ActiveAt,
+		From:       req.From,
+		To:         req.To,
+		NamePrefix: req.NamePrefix,
+		Category:   req.Category,
+		OrderBy:    req.OrderBy,
+		Descending: req.Descending,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
+	}
+	if err := validate.Struct(list); err != nil {
+		return nil, err
+	}
+	budgets, next, err := s.BudgetSRV.GetBudgetList(ctx, list)
 	if err != nil {
 		return nil, err
 	}
-	protobudgets := convertToProtoBudgets(budgets)
+	protobudgets := convertToProtoBudgets(budgets, req.UserId)
 	return &budgetProto.GetBudgetListResponse{
-		Budgets: protobudgets,
+		Budgets:       protobudgets,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -224,29 +296,83 @@ var (
 	DateTimeformat string = "2006-01-02T15:04:05"
 )
 
-func convertToProtoBudgets(budgets []models.Budget) []*budgetProto.Budget {
+// convertToProtoBudgets marks the budgets with the role userID has on them.
+func convertToProtoBudgets(budgets []models.Budget, userID string) []*budgetProto.Budget {
 	protoBudgets := make([]*budgetProto.Budget, len(budgets))
-	for i, b := range budgets {
-		protoBudgets[i] = &budgetProto.Budget{
-			BudgetId: b.ID,
-			Name:     b.Name,
-			Limit:    float32(b.Limit),
-			Start:    b.StartDate.Format(Dateformat),
-			End:      b.EndDate.Format(Dateformat),
-			Category: convertToProtoCategories(b.Category),
-		}
+	for i := range budgets {
+		protoBudgets[i] = convertToProtoBudget(&budgets[i], userID)
 	}
 	return protoBudgets
 }
 
+func convertToProtoBudget(budget *models.Budget, userID string) *budgetProto.Budget {
+	protoBudget := &budgetProto.Budget{
+		BudgetId:   budget.ID,
+		Name:       budget.Name,
+		Limit:      toProtoMoney(budget.Limit),
+		Currency:   budget.Currency,
+		Version:    budget.Version,
+		Allocation: budget.Allocation,
+		Start:      budget.StartDate.Format(Dateformat),
+		End:        budget.EndDate.Format(Dateformat),
+		Category:   convertToProtoCategories(budget.Category),
+		SeriesId:   budget.SeriesID,
+		OwnerId:    budget.UserID,
+		Role:       budget.RoleOf(userID),
+	}
+	if budget.Recurrence != nil {
+		protoBudget.Recurring = budget.Recurrence.Active
+		protoBudget.Period = budget.Recurrence.Period
+	}
+	if budget.DeletedAt != nil {
+		protoBudget.DeletedAt = budget.DeletedAt.Format(time.RFC3339)
+	}
+	return protoBudget
+}
+
 func convertToProtoCategories(categories []models.Category) []*budgetProto.Category {
 	protoBudgets := make([]*budgetProto.Category, len(categories))
 	for i, c := range categories {
 		protoBudgets[i] = &budgetProto.Category{
-			CategoryId: c.ID,
-			Name:       c.Name,
-			Limit:      float32(c.Limit),
+			CategoryId:     c.ID,
+			Name:           c.Name,
+			Limit:          toProtoMoney(c.Limit),
+			Carried:        toProtoMoney(c.Carried),
+			EffectiveLimit: toProtoMoney(c.EffectiveLimit()),
+		}
+		if c.Rollover != nil {
+			protoBudgets[i].RolloverMode = c.Rollover.Mode
+			protoBudgets[i].RolloverCap = toProtoMoney(c.Rollover.Cap)
+		}
+		if c.DeletedAt != nil {
+			protoBudgets[i].DeletedAt = c.DeletedAt.Format(time.RFC3339)
 		}
 	}
 	return protoBudgets
 }
+
+func convertToProtoSummary(summary *models.BudgetSummary) *budgetProto.BudgetSummary {
+	categories := make([]*budgetProto.CategorySummary, len(summary.Categories))
+	for i, c := range summary.Categories {
+		categories[i] = &budgetProto.CategorySummary{
+			CategoryId:  c.CategoryID,
+			Name:        c.Name,
+			Limit:       toProtoMoney(c.Limit),
+			Spent:       toProtoMoney(c.Spent),
+			Remaining:   toProtoMoney(c.Remaining),
+			PercentUsed: float32(c.PercentUsed),
+		}
+	}
+	return &budgetProto.BudgetSummary{
+		BudgetId:      summary.BudgetID,
+		Name:          summary.Name,
+		Limit:         toProtoMoney(summary.Limit),
+		Spent:         toProtoMoney(summary.Spent),
+		Remaining:     toProtoMoney(summary.Remaining),
+		PercentUsed:   float32(summary.PercentUsed),
+		DaysElapsed:   int32(summary.DaysElapsed),
+		DaysRemaining: int32(summary.DaysRemaining),
+		Unallocated:   toProtoMoney(summary.Unallocated),
+		Categories:    categories,
+	}
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) CloneBudget(ctx context.Context, req *budgetProto.CloneBudgetRequest) (*budgetProto.GetBudgetResponse, error) {
+	clone := models.CloneBudget{
+		UserID:    req.UserId,
+		BudgetID:  req.BudgetId,
+		StartDate: req.Start,
+		EndDate:   req.End,
+	}
+	if req.Scale != "" {
+		scale, err := models.ParsePercent(req.Scale)
+		if err != nil {
+			return nil, apperrors.InvalidArgument("%v", err)
+		}
+		clone.Scale = scale
+	}
+	if err := validate.Struct(clone); err != nil {
+		return nil, err
+	}
+	budget, warnings, err := s.BudgetSRV.CloneBudget(ctx, clone)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetResponse{
+		Budget:   convertToProtoBudget(budget, req.UserId),
+		Warnings: warnings,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"io"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) ExportBudgets(req *budgetProto.ExportBudgetsRequest, stream budgetProto.BudgetService_ExportBudgetsServer) error {
+	export := models.ExportBudgets{
+		UserID: req.UserId,
+	}
+	if err := validate.Struct(export); err != nil {
+		return err
+	}
+	return s.BudgetSRV.ExportBudgets(stream.Context(), export.UserID, chunkWriter(func(data []byte) error {
+		return stream.Send(&budgetProto.CsvChunk{Data: data})
+	}))
+}
+
+func (s *BudgetServiceServer) ImportBudgets(stream budgetProto.BudgetService_ImportBudgetsServer) error {
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return apperrors.InvalidArgument("no data received")
+	}
+	if err != nil {
+		return err
+	}
+	importBudgets := models.ImportBudgets{
+		UserID: req.UserId,
+		DryRun: req.DryRun,
+	}
+	if err := validate.Struct(importBudgets); err != nil {
+		return err
+	}
+	r := &chunkReader{data: req.Data, recv: func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	}}
+	result, err := s.BudgetSRV.ImportBudgets(stream.Context(), importBudgets.UserID, r, importBudgets.DryRun)
+	if err != nil {
+		return err
+	}
+	problems := make([]*budgetProto.ImportProblem, len(result.Problems))
+	for i, problem := range result.Problems {
+		problems[i] = &budgetProto.ImportProblem{
+			Row:     int32(problem.Row),
+			Kind:    problem.Kind,
+			Message: problem.Message,
+		}
+	}
+	return stream.SendAndClose(&budgetProto.ImportBudgetsResponse{
+		Budgets:    int32(result.Budgets),
+		Categories: int32(result.Categories),
+		Problems:   problems,
+		Committed:  result.Committed,
+	})
+}
+
+// chunkWriter sends every write as a chunk of a stream.
+type chunkWriter func(data []byte) error
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// chunkReader reads the chunks of a stream as one file, until recv returns
+// io.EOF.
+type chunkReader struct {
+	data []byte
+	recv func() ([]byte, error)
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		data, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
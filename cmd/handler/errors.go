@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryErrorInterceptor translates errors returned by the handlers into gRPC
+// statuses so clients can rely on codes instead of error messages.
+func UnaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, toStatusError(err)
+	}
+	return resp, nil
+}
+
+// StreamErrorInterceptor does the same for streaming handlers.
+func StreamErrorInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return toStatusError(err)
+	}
+	return nil
+}
+
+func toStatusError(err error) error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return validationStatus(validationErrs)
+	}
+	var appErr *apperrors.Error
+	if errors.As(err, &appErr) {
+		return status.Error(statusCode(appErr), appErr.Error())
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	// errors coming from downstream gRPC services already carry a status
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+	// storage and driver errors may name collections or hosts, so clients
+	// only learn that something failed
+	log.Printf("internal error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func statusCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, apperrors.ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(err, apperrors.ErrAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, apperrors.ErrConflict):
+		return codes.Aborted
+	case errors.Is(err, apperrors.ErrFailedPrecondition):
+		return codes.FailedPrecondition
+	case errors.Is(err, apperrors.ErrUnavailable):
+		return codes.Unavailable
+	case errors.Is(err, apperrors.ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, apperrors.ErrPermissionDenied):
+		return codes.PermissionDenied
+	default:
+		return codes.Unknown
+	}
+}
+
+func validationStatus(errs validator.ValidationErrors) error {
+	badRequest := &errdetails.BadRequest{}
+	fields := make([]string, 0, len(errs))
+	for _, fe := range errs {
+		field := protoFieldName(fe.Field())
+		fields = append(fields, field)
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: "failed on the '" + fe.Tag() + "' rule",
+		})
+	}
+	st := status.New(codes.InvalidArgument, "invalid fields: "+strings.Join(fields, ", "))
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// protoFieldName maps a model field name such as "BudgetID" to the name of
+// the matching request field ("budgetId").
+func protoFieldName(field string) string {
+	if field == "" {
+		return field
+	}
+	if strings.HasSuffix(field, "ID") {
+		field = strings.TrimSuffix(field, "ID") + "Id"
+	}
+	return strings.ToLower(field[:1]) + field[1:]
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"not found", apperrors.NotFound("budget not found"), codes.NotFound, "budget not found"},
+		{"wrapped", fmt.Errorf("restore: %w", apperrors.InvalidArgument("bad")), codes.InvalidArgument, "bad"},
+		{"conflict", apperrors.Conflict("changed"), codes.Aborted, "changed"},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded, context.DeadlineExceeded.Error()},
+		{"downstream", status.Error(codes.Unavailable, "user service down"), codes.Unavailable, "user service down"},
+		{"internal", errors.New(`(BadValue) mkbudgets.budgets: dial tcp mongodb://db:27017`), codes.Internal, "internal error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, _ := status.FromError(toStatusError(tt.err))
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Fatalf("got %v %q, want %v %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *BudgetServiceServer) AddExpense(ctx context.Context, req *budgetProto.AddExpenseRequest) (*budgetProto.AddExpenseResponse, error) {
+	amount, err := fromProtoMoney("amount", req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	addExpense := models.CreateExpense{
+		UserID:     req.UserId,
+		BudgetID:   req.BudgetId,
+		CategoryID: req.CategoryId,
+		Amount:     amount,
+		Currency:   req.Currency,
+		Date:       req.Date,
+		Note:       req.Note,
+	}
+	if err := validate.Struct(addExpense); err != nil {
+		return nil, err
+	}
+	expenseID, err := s.BudgetSRV.AddExpense(ctx, addExpense)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.AddExpenseResponse{
+		ExpenseId: expenseID,
+	}, nil
+}
+
+func (s *BudgetServiceServer) ListExpenses(ctx context.Context, req *budgetProto.ListExpensesRequest) (*budgetProto.ListExpensesResponse, error) {
+	expenses, err := s.BudgetSRV.ListExpenses(ctx, req.UserId, req.BudgetId, req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.ListExpensesResponse{
+		Expenses: convertToProtoExpenses(expenses),
+	}, nil
+}
+
+func (s *BudgetServiceServer) DeleteExpense(ctx context.Context, req *budgetProto.DeleteExpenseRequest) (*emptypb.Empty, error) {
+	err := s.BudgetSRV.DeleteExpense(ctx, req.UserId, req.ExpenseId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func convertToProtoExpenses(expenses []models.Expense) []*budgetProto.Expense {
+	protoExpenses := make([]*budgetProto.Expense, len(expenses))
+	for i, e := range expenses {
+		protoExpenses[i] = &budgetProto.Expense{
+			ExpenseId:  e.ID,
+			BudgetId:   e.BudgetID,
+			CategoryId: e.CategoryID,
+			Amount:     toProtoMoney(e.Amount),
+			Currency:   e.Currency,
+			Date:       e.Date.Format(Dateformat),
+			Note:       e.Note,
+			CreatedBy:  e.CreatedBy,
+		}
+		if e.Original != nil {
+			protoExpenses[i].OriginalAmount = toProtoMoney(e.Original.Amount)
+			protoExpenses[i].OriginalCurrency = e.Original.Currency
+			protoExpenses[i].ExchangeRate = e.Original.Rate
+			protoExpenses[i].RateDate = e.Original.RateDate.Format(Dateformat)
+		}
+	}
+	return protoExpenses
+}
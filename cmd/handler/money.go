@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func toProtoMoney(m models.Money) *budgetProto.Money {
+	units, nanos := m.Units()
+	return &budgetProto.Money{Units: units, Nanos: nanos}
+}
+
+// fromProtoMoney treats a missing amount as zero.
+func fromProtoMoney(field string, m *budgetProto.Money) (models.Money, error) {
+	if m == nil {
+		return 0, nil
+	}
+	money, err := models.MoneyFromUnits(m.Units, m.Nanos)
+	if err != nil {
+		return 0, apperrors.InvalidArgument("invalid %s: %v", field, err)
+	}
+	return money, nil
+}
+
+// optionalMoney returns nil when the amount is not set.
+func optionalMoney(field string, m *budgetProto.Money) (*models.Money, error) {
+	if m == nil {
+		return nil, nil
+	}
+	money, err := fromProtoMoney(field, m)
+	if err != nil {
+		return nil, err
+	}
+	return &money, nil
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"strings"
+
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OpenAPI describes the Routes as an OpenAPI 3 document, built from the
+// descriptors of budget.proto so it follows the messages as they change.
+func OpenAPI() ([]byte, error) {
+	schemas := map[string]any{
+		"Status": map[string]any{
+			"type":        "object",
+			"description": "google.rpc.Status, the body of every error response",
+			"properties": map[string]any{
+				"code":    map[string]any{"type": "integer", "format": "int32"},
+				"message": map[string]any{"type": "string"},
+				"details": map[string]any{
+					"type": "array",
+					"items": map[string]any{
+						"type":                 "object",
+						"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+						"additionalProperties": true,
+					},
+				},
+			},
+		},
+	}
+	paths := map[string]any{}
+	for _, route := range Routes {
+		method := routeMethod(route)
+		operation := map[string]any{
+			"operationId": route.RPC,
+			"tags":        []string{string(method.Parent().Name())},
+		}
+
+		target := method.Input()
+		if route.Body != "" && route.Body != "*" {
+			target = target.Fields().ByJSONName(route.Body).Message()
+		}
+		parameters := []any{}
+		inPath := map[string]bool{}
+		for _, name := range pathParams(route.Path) {
+			inPath[name] = true
+			parameters = append(parameters, map[string]any{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   fieldSchema(target.Fields().ByJSONName(name), schemas),
+			})
+		}
+		if route.Body == "" {
+			fields := method.Input().Fields()
+			for i := 0; i < fields.Len(); i++ {
+				field := fields.Get(i)
+				if inPath[field.JSONName()] || field.IsList() || field.IsMap() ||
+					field.Kind() == protoreflect.MessageKind && !isWrapper(field.Message()) {
+					continue
+				}
+				parameters = append(parameters, map[string]any{
+					"name":   field.JSONName(),
+					"in":     "query",
+					"schema": fieldSchema(field, schemas),
+				})
+			}
+		} else {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": messageSchema(target, schemas)},
+				},
+			}
+		}
+		operation["parameters"] = parameters
+
+		contentType, description := "application/json", "OK"
+		if method.IsStreamingServer() {
+			contentType, description = "application/x-ndjson", "one message per line"
+		}
+		operation["responses"] = map[string]any{
+			"200": map[string]any{
+				"description": description,
+				"content": map[string]any{
+					contentType: map[string]any{"schema": messageSchema(method.Output(), schemas)},
+				},
+			},
+			"default": map[string]any{
+				"description": "error",
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Status"}},
+				},
+			},
+		}
+
+		item, ok := paths[route.Path].(map[string]any)
+		if !ok {
+			item = map[string]any{}
+			paths[route.Path] = item
+		}
+		item[strings.ToLower(route.Method)] = operation
+	}
+
+	service := budgetProto.File_budget_budget_proto.Services().Get(0)
+	doc := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   string(service.FullName()),
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// messageSchema returns a reference to the schema of the message, adding it
+// and the messages it refers to to schemas.
+func messageSchema(desc protoreflect.MessageDescriptor, schemas map[string]any) map[string]any {
+	if desc.FullName() == "google.protobuf.Empty" {
+		return map[string]any{"type": "object"}
+	}
+	name := string(desc.Name())
+	ref := map[string]any{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+	properties := map[string]any{}
+	schema := map[string]any{"type": "object", "properties": properties}
+	// registered before the fields, so recursive messages terminate
+	schemas[name] = schema
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		properties[fields.Get(i).JSONName()] = fieldSchema(fields.Get(i), schemas)
+	}
+	return ref
+}
+
+// fieldSchema follows the proto3 JSON mapping, which for instance encodes
+// 64-bit integers as strings.
+func fieldSchema(field protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	if field.IsMap() {
+		// JSON object keys are strings whatever the key type
+		return map[string]any{"type": "object", "additionalProperties": fieldSchema(field.MapValue(), schemas)}
+	}
+	var schema map[string]any
+	switch field.Kind() {
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		schema = map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		schema = map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.BoolKind:
+		schema = map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		schema = map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		schema = map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		schema = map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		schema = map[string]any{"type": "number", "format": "double"}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if isWrapper(field.Message()) {
+			schema = fieldSchema(field.Message().Fields().ByName("value"), schemas)
+			schema["nullable"] = true
+		} else {
+			schema = messageSchema(field.Message(), schemas)
+		}
+	}
+	if field.IsList() {
+		return map[string]any{"type": "array", "items": schema}
+	}
+	return schema
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) ListBudgetSeries(ctx context.Context, req *budgetProto.ListBudgetSeriesRequest) (*budgetProto.GetBudgetListResponse, error) {
+	budgets, err := s.BudgetSRV.ListBudgetSeries(ctx, req.UserId, req.SeriesId)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetListResponse{
+		Budgets: convertToProtoBudgets(budgets, req.UserId),
+	}, nil
+}
+
+func (s *BudgetServiceServer) StopRecurrence(ctx context.Context, req *budgetProto.StopRecurrenceRequest) (*budgetProto.GetBudgetResponse, error) {
+	budget, err := s.BudgetSRV.StopRecurrence(ctx, req.UserId, req.BudgetId, optionalVersion(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetResponse{
+		Budget: convertToProtoBudget(budget, req.UserId),
+	}, nil
+}
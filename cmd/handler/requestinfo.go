@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"path"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/requestinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDHeader = "x-request-id"
+
+// UnaryRequestInfoInterceptor stores the RPC name and the request ID in the
+// context for the audit log. The request ID is taken from the x-request-id
+// metadata, or generated, and returned in the response header.
+func UnaryRequestInfoInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	reqInfo, header := newRequestInfo(ctx, info.FullMethod)
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.Println(err)
+	}
+	return handler(requestinfo.NewContext(ctx, reqInfo), req)
+}
+
+// StreamRequestInfoInterceptor does the same for streaming handlers.
+func StreamRequestInfoInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	reqInfo, header := newRequestInfo(ss.Context(), info.FullMethod)
+	if err := ss.SetHeader(header); err != nil {
+		log.Println(err)
+	}
+	return handler(srv, &requestInfoStream{ServerStream: ss, ctx: requestinfo.NewContext(ss.Context(), reqInfo)})
+}
+
+type requestInfoStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestInfoStream) Context() context.Context {
+	return s.ctx
+}
+
+func newRequestInfo(ctx context.Context, fullMethod string) (requestinfo.Info, metadata.MD) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+	info := requestinfo.Info{
+		RPC:       path.Base(fullMethod),
+		RequestID: requestID,
+	}
+	return info, metadata.Pairs(requestIDHeader, requestID)
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Println(err)
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
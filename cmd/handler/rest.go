@@ -0,0 +1,475 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Route exposes a BudgetService RPC over HTTP. Path parameters are bound to
+// the request fields of the same name and, for routes without a body, query
+// parameters as well. Body names the request field the JSON body is decoded
+// into, or is "*" for the whole request; path parameters then go into that
+// field.
+type Route struct {
+	Method string
+	Path   string
+	RPC    string
+	Body   string
+}
+
+var Routes = []Route{
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets", RPC: "AddBudget", Body: "*"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/budgets", RPC: "GetBudgetList"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/budgets/{budgetId}", RPC: "GetBudget"},
+	{Method: http.MethodPatch, Path: "/v1/users/{userId}/budgets/{budgetId}", RPC: "UpdateBudget", Body: "update"},
+	{Method: http.MethodDelete, Path: "/v1/users/{userId}/budgets/{budgetId}", RPC: "DeleteBudget"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/budgets/{budgetId}/summary", RPC: "GetBudgetSummary"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/budgets/{budgetId}/history", RPC: "GetBudgetHistory"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets/{budgetId}/stop-recurrence", RPC: "StopRecurrence", Body: "*"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/budgets/{budgetId}/members", RPC: "ListMembers"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets/{budgetId}/members", RPC: "ShareBudget", Body: "*"},
+	{Method: http.MethodDelete, Path: "/v1/users/{userId}/budgets/{budgetId}/members/{memberId}", RPC: "RevokeShare"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets/{budgetId}/clone", RPC: "CloneBudget", Body: "*"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets/{budgetId}/save-as-template", RPC: "SaveBudgetAsTemplate", Body: "*"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets/{budgetId}/categories", RPC: "AddCategory", Body: "*"},
+	{Method: http.MethodPatch, Path: "/v1/users/{userId}/budgets/{budgetId}/categories/{categoryId}", RPC: "UpdateCategory", Body: "update"},
+	{Method: http.MethodDelete, Path: "/v1/users/{userId}/budgets/{budgetId}/categories/{categoryId}", RPC: "DeleteCategory"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/budgets/{budgetId}/expenses", RPC: "AddExpense", Body: "*"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/budgets/{budgetId}/expenses", RPC: "ListExpenses"},
+	{Method: http.MethodDelete, Path: "/v1/users/{userId}/expenses/{expenseId}", RPC: "DeleteExpense"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/series/{seriesId}", RPC: "ListBudgetSeries"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/backup", RPC: "BackupUserData"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/restore", RPC: "RestoreUserData", Body: "*"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/export", RPC: "ExportBudgets"},
+	// the whole file is uploaded in a single message
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/import", RPC: "ImportBudgets", Body: "*"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/templates", RPC: "CreateTemplate", Body: "*"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/templates", RPC: "ListTemplates"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/templates/{templateId}", RPC: "GetTemplate"},
+	{Method: http.MethodPut, Path: "/v1/users/{userId}/templates/{templateId}", RPC: "UpdateTemplate", Body: "*"},
+	{Method: http.MethodDelete, Path: "/v1/users/{userId}/templates/{templateId}", RPC: "DeleteTemplate"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/templates/{templateId}/budgets", RPC: "AddBudgetFromTemplate", Body: "*"},
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/trash", RPC: "ListDeleted"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/trash/budgets/{budgetId}/restore", RPC: "RestoreBudget", Body: "*"},
+	{Method: http.MethodPost, Path: "/v1/users/{userId}/trash/budgets/{budgetId}/categories/{categoryId}/restore", RPC: "RestoreCategory", Body: "*"},
+	// answered with newline-delimited JSON, one event per line
+	{Method: http.MethodGet, Path: "/v1/users/{userId}/watch", RPC: "WatchBudgets"},
+}
+
+const maxBodySize = 1 << 20
+
+var (
+	marshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{}
+)
+
+// NewRESTGateway serves the Routes by calling the gRPC handlers in process,
+// through the given interceptors. Request headers are passed to them as
+// incoming metadata and the headers they set are returned as response
+// headers. The OpenAPI document is served at /v1/openapi.json.
+func NewRESTGateway(budgetSRV BudgetService, unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) (http.Handler, error) {
+	server := &BudgetServiceServer{BudgetSRV: budgetSRV}
+	desc := budgetProto.BudgetService_ServiceDesc
+	methods := make(map[string]grpc.MethodDesc, len(desc.Methods))
+	for _, method := range desc.Methods {
+		methods[method.MethodName] = method
+	}
+	streams := make(map[string]grpc.StreamDesc, len(desc.Streams))
+	for _, stream := range desc.Streams {
+		streams[stream.StreamName] = stream
+	}
+	doc, err := OpenAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(doc)
+	})
+	for _, route := range Routes {
+		if err := checkRoute(route); err != nil {
+			return nil, err
+		}
+		fullMethod := "/" + desc.ServiceName + "/" + route.RPC
+		if method, ok := methods[route.RPC]; ok {
+			interceptor := chainUnary(unary)
+			mux.HandleFunc(route.Method+" "+route.Path, func(w http.ResponseWriter, r *http.Request) {
+				ctx := gatewayContext(w, r, fullMethod)
+				decode := func(req any) error {
+					return bindRequest(r, route, req.(proto.Message))
+				}
+				resp, err := method.Handler(server, ctx, decode, interceptor)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				writeMessage(w, resp.(proto.Message))
+			})
+			continue
+		}
+		streamDesc := streams[route.RPC]
+		interceptor := chainStream(stream)
+		info := &grpc.StreamServerInfo{
+			FullMethod:     fullMethod,
+			IsClientStream: streamDesc.ClientStreams,
+			IsServerStream: streamDesc.ServerStreams,
+		}
+		mux.HandleFunc(route.Method+" "+route.Path, func(w http.ResponseWriter, r *http.Request) {
+			ss := &restStream{w: w, r: r, route: route, desc: streamDesc, ctx: gatewayContext(w, r, fullMethod)}
+			err := interceptor(server, ss, info, streamDesc.Handler)
+			if err != nil {
+				if !ss.sent {
+					writeError(w, err)
+					return
+				}
+				// the status line is gone, the error ends the stream instead
+				st := errorStatus(err)
+				data, merr := marshalOptions.Marshal(st.Proto())
+				if merr != nil {
+					log.Println(merr)
+					return
+				}
+				fmt.Fprintf(w, "{\"error\":%s}\n", data)
+			}
+		})
+	}
+	return mux, nil
+}
+
+// checkRoute makes sure the route's RPC and the fields it binds exist.
+func checkRoute(route Route) error {
+	method := routeMethod(route)
+	if method == nil {
+		return fmt.Errorf("route %s %s: unknown rpc %s", route.Method, route.Path, route.RPC)
+	}
+	target := method.Input()
+	if route.Body != "" && route.Body != "*" {
+		field := target.Fields().ByJSONName(route.Body)
+		if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() {
+			return fmt.Errorf("route %s %s: body field %s is not a message", route.Method, route.Path, route.Body)
+		}
+		target = field.Message()
+	}
+	for _, name := range pathParams(route.Path) {
+		if target.Fields().ByJSONName(name) == nil {
+			return fmt.Errorf("route %s %s: unknown path parameter %s", route.Method, route.Path, name)
+		}
+	}
+	return nil
+}
+
+func routeMethod(route Route) protoreflect.MethodDescriptor {
+	service := budgetProto.File_budget_budget_proto.Services().Get(0)
+	return service.Methods().ByName(protoreflect.Name(route.RPC))
+}
+
+// gatewayContext makes the request look like an incoming gRPC call to the
+// handlers and interceptors.
+func gatewayContext(w http.ResponseWriter, r *http.Request, fullMethod string) context.Context {
+	md := metadata.MD{}
+	for name, values := range r.Header {
+		md.Append(strings.ToLower(name), values...)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	return grpc.NewContextWithServerTransportStream(ctx, &transportStream{w: w, method: fullMethod})
+}
+
+// transportStream returns the headers set by the handlers with the HTTP
+// response. Trailers are dropped.
+type transportStream struct {
+	w      http.ResponseWriter
+	method string
+}
+
+func (s *transportStream) Method() string {
+	return s.method
+}
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	for name, values := range md {
+		for _, value := range values {
+			s.w.Header().Add(name, value)
+		}
+	}
+	return nil
+}
+
+func (s *transportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *transportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+// restStream serves a streaming RPC. A server-streaming RPC has every message
+// written as a line of JSON as soon as it is sent; a client-streaming one
+// receives the request as its only message.
+type restStream struct {
+	w        http.ResponseWriter
+	r        *http.Request
+	route    Route
+	desc     grpc.StreamDesc
+	ctx      context.Context
+	sent     bool
+	received bool
+}
+
+func (s *restStream) SetHeader(md metadata.MD) error {
+	return grpc.ServerTransportStreamFromContext(s.ctx).SetHeader(md)
+}
+
+func (s *restStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *restStream) SetTrailer(md metadata.MD) {}
+
+func (s *restStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *restStream) SendMsg(m any) error {
+	if !s.desc.ServerStreams {
+		s.sent = true
+		writeMessage(s.w, m.(proto.Message))
+		return nil
+	}
+	data, err := marshalOptions.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	if !s.sent {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.sent = true
+	}
+	if _, err := s.w.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+func (s *restStream) RecvMsg(m any) error {
+	if s.received && s.desc.ClientStreams {
+		return io.EOF
+	}
+	s.received = true
+	return bindRequest(s.r, s.route, m.(proto.Message))
+}
+
+func chainUnary(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func chainStream(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(srv any, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// bindRequest fills the request from the JSON body, the path parameters and
+// the query parameters of r, in that order. The query cannot repeat a path
+// parameter, so the URL always names the resource the handler acts on.
+func bindRequest(r *http.Request, route Route, req proto.Message) error {
+	msg := req.ProtoReflect()
+	target := msg
+	if route.Body != "" {
+		data, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		if err != nil {
+			return err
+		}
+		if len(data) > maxBodySize {
+			return apperrors.InvalidArgument("request body is too large")
+		}
+		if route.Body != "*" {
+			field := msg.Descriptor().Fields().ByJSONName(route.Body)
+			target = msg.Mutable(field).Message()
+		}
+		if len(data) > 0 {
+			if err := unmarshalOptions.Unmarshal(data, target.Interface()); err != nil {
+				return apperrors.InvalidArgument("invalid request body: %v", err)
+			}
+		}
+	}
+	params := pathParams(route.Path)
+	for _, name := range params {
+		if err := setField(target, name, r.PathValue(name)); err != nil {
+			return err
+		}
+	}
+	if route.Body == "" {
+		for name, values := range r.URL.Query() {
+			if slices.Contains(params, name) {
+				return apperrors.InvalidArgument("parameter %s is part of the path and cannot be set in the query", name)
+			}
+			if err := setField(msg, name, values[len(values)-1]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func pathParams(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, segment[1:len(segment)-1])
+		}
+	}
+	return names
+}
+
+// setField sets a singular scalar field, or the value of a wrapper message
+// such as google.protobuf.Int64Value, from its text form.
+func setField(msg protoreflect.Message, name, value string) error {
+	field := msg.Descriptor().Fields().ByJSONName(name)
+	if field == nil || field.IsList() || field.IsMap() {
+		return apperrors.InvalidArgument("unknown parameter %s", name)
+	}
+	if field.Kind() == protoreflect.MessageKind {
+		if !isWrapper(field.Message()) {
+			return apperrors.InvalidArgument("parameter %s cannot be set from the url", name)
+		}
+		return setField(msg.Mutable(field).Message(), "value", value)
+	}
+	v, err := scalarValue(field, value)
+	if err != nil {
+		return apperrors.InvalidArgument("invalid value for %s: %q", name, value)
+	}
+	msg.Set(field, v)
+	return nil
+}
+
+func isWrapper(desc protoreflect.MessageDescriptor) bool {
+	return desc.ParentFile().Package() == "google.protobuf" && strings.HasSuffix(string(desc.Name()), "Value") &&
+		desc.Fields().Len() == 1 && desc.Fields().ByName("value") != nil
+}
+
+func scalarValue(field protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	default:
+		return protoreflect.Value{}, errors.New("unsupported field kind")
+	}
+}
+
+func writeMessage(w http.ResponseWriter, m proto.Message) {
+	data, err := marshalOptions.Marshal(m)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// writeError answers with the HTTP status matching the gRPC code of err and
+// the google.rpc.Status as body, the same shape for every error.
+func writeError(w http.ResponseWriter, err error) {
+	st := errorStatus(err)
+	data, merr := marshalOptions.Marshal(st.Proto())
+	if merr != nil {
+		log.Println(merr)
+		data = []byte(`{"code":13,"message":"failed to encode error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(data)
+}
+
+func errorStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	st, _ := status.FromError(toStatusError(err))
+	return st
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// nginx's "client closed request"
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/events"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository/memory"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func routeFor(t *testing.T, rpc string) Route {
+	t.Helper()
+	for _, route := range Routes {
+		if route.RPC == rpc {
+			return route
+		}
+	}
+	t.Fatalf("no route for %s", rpc)
+	return Route{}
+}
+
+func TestBindRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		rpc    string
+		target string
+		body   string
+		path   map[string]string
+		req    proto.Message
+		want   proto.Message
+		err    error
+	}{
+		{
+			name:   "whole body",
+			rpc:    "AddBudget",
+			target: "/v1/users/u1/budgets?name=ignored",
+			body:   `{"userId":"u2","name":"food","period":"month","limit":{"units":"100","nanos":500000000}}`,
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.AddBudgetRequest{},
+			want: &budgetProto.AddBudgetRequest{UserId: "u1", Name: "food", Period: "month",
+				Limit: &budgetProto.Money{Units: 100, Nanos: 500000000}},
+		},
+		{
+			name:   "empty body",
+			rpc:    "AddBudget",
+			target: "/v1/users/u1/budgets",
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.AddBudgetRequest{},
+			want:   &budgetProto.AddBudgetRequest{UserId: "u1"},
+		},
+		{
+			name:   "invalid body",
+			rpc:    "AddBudget",
+			target: "/v1/users/u1/budgets",
+			body:   `{"name":`,
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.AddBudgetRequest{},
+			err:    apperrors.ErrInvalidArgument,
+		},
+		{
+			name:   "body too large",
+			rpc:    "AddBudget",
+			target: "/v1/users/u1/budgets",
+			body:   `{"name":"` + strings.Repeat("x", maxBodySize) + `"}`,
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.AddBudgetRequest{},
+			err:    apperrors.ErrInvalidArgument,
+		},
+		{
+			name:   "body field",
+			rpc:    "UpdateBudget",
+			target: "/v1/users/u1/budgets/b1",
+			body:   `{"name":"renamed","version":"3"}`,
+			path:   map[string]string{"userId": "u1", "budgetId": "b1"},
+			req:    &budgetProto.UpdateBudgetRequest{},
+			want: &budgetProto.UpdateBudgetRequest{Update: &budgetProto.UpdateBudget{
+				UserId: "u1", BudgetId: "b1", Name: wrapperspb.String("renamed"), Version: wrapperspb.Int64(3),
+			}},
+		},
+		{
+			name:   "query parameters",
+			rpc:    "GetBudgetList",
+			target: "/v1/users/u1/budgets?namePrefix=fo&descending=true&pageSize=10&pageSize=20",
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.GetBudgetListRequest{},
+			want:   &budgetProto.GetBudgetListRequest{UserId: "u1", NamePrefix: "fo", Descending: true, PageSize: 20},
+		},
+		{
+			name:   "wrapper query parameter",
+			rpc:    "DeleteBudget",
+			target: "/v1/users/u1/budgets/b1?version=7",
+			path:   map[string]string{"userId": "u1", "budgetId": "b1"},
+			req:    &budgetProto.DeleteBudgetRequest{},
+			want:   &budgetProto.DeleteBudgetRequest{UserId: "u1", BudgetId: "b1", Version: wrapperspb.Int64(7)},
+		},
+		{
+			name:   "query repeats the path",
+			rpc:    "GetBudgetList",
+			target: "/v1/users/u1/budgets?userId=u2",
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.GetBudgetListRequest{},
+			err:    apperrors.ErrInvalidArgument,
+		},
+		{
+			name:   "unknown query parameter",
+			rpc:    "GetBudgetList",
+			target: "/v1/users/u1/budgets?bogus=1",
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.GetBudgetListRequest{},
+			err:    apperrors.ErrInvalidArgument,
+		},
+		{
+			name:   "invalid query value",
+			rpc:    "GetBudgetList",
+			target: "/v1/users/u1/budgets?pageSize=many",
+			path:   map[string]string{"userId": "u1"},
+			req:    &budgetProto.GetBudgetListRequest{},
+			err:    apperrors.ErrInvalidArgument,
+		},
+		{
+			name:   "invalid wrapper value",
+			rpc:    "DeleteBudget",
+			target: "/v1/users/u1/budgets/b1?version=latest",
+			path:   map[string]string{"userId": "u1", "budgetId": "b1"},
+			req:    &budgetProto.DeleteBudgetRequest{},
+			err:    apperrors.ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := routeFor(t, tt.rpc)
+			r := httptest.NewRequest(route.Method, tt.target, strings.NewReader(tt.body))
+			for name, value := range tt.path {
+				r.SetPathValue(name, value)
+			}
+			err := bindRequest(r, route, tt.req)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("bindRequest() error = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && !proto.Equal(tt.req, tt.want) {
+				t.Fatalf("bound %v, want %v", tt.req, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Canceled, 499},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.Aborted, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.DataLoss, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		if got := httpStatus(tt.code); got != tt.want {
+			t.Errorf("httpStatus(%v) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
+
+type restUser struct{}
+
+func (restUser) GetUser(ctx context.Context, id string) (string, string, error) {
+	return id, "name", nil
+}
+
+func TestRESTGateway(t *testing.T) {
+	broker := events.NewBroker(10)
+	outbox := memory.NewOutboxRepository()
+	svc := service.NewBudgetService(memory.NewBudgetRepository(), memory.NewExpenseRepository(), memory.NewTemplateRepository(),
+		memory.NewAuditRepository(), outbox, memory.NewTransactor(), broker, memory.NewLocker(), restUser{}, nil, "USD")
+	gateway, err := NewRESTGateway(svc,
+		[]grpc.UnaryServerInterceptor{UnaryRequestInfoInterceptor, UnaryErrorInterceptor},
+		[]grpc.StreamServerInterceptor{StreamRequestInfoInterceptor, StreamErrorInterceptor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(gateway)
+	defer srv.Close()
+
+	call := func(method, path, body string, resp any) int {
+		t.Helper()
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		return res.StatusCode
+	}
+
+	// the watch is opened first, so it sees the budget being created
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watchReq, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/users/u1/watch", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watch, err := http.DefaultClient.Do(watchReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watch.Body.Close()
+	if watch.StatusCode != http.StatusOK || watch.Header.Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("watch: %d %s", watch.StatusCode, watch.Header.Get("Content-Type"))
+	}
+	lines := bufio.NewScanner(watch.Body)
+	nextEvent := func() map[string]any {
+		t.Helper()
+		if !lines.Scan() {
+			t.Fatalf("watch ended: %v", lines.Err())
+		}
+		var event map[string]any
+		if err := json.Unmarshal(lines.Bytes(), &event); err != nil {
+			t.Fatal(err)
+		}
+		return event
+	}
+	if event := nextEvent(); event["type"] != models.EventWatchStarted {
+		t.Fatalf("first event %v", event)
+	}
+
+	var added struct{ BudgetID string }
+	code := call(http.MethodPost, "/v1/users/u1/budgets", `{"name":"food","period":"month","limit":{"units":"100"}}`, &added)
+	if code != http.StatusOK || added.BudgetID == "" {
+		t.Fatalf("add budget: %d %+v", code, added)
+	}
+
+	relay := service.NewOutboxRelay(outbox, broker, 10, 3, time.Second)
+	if err := relay.RelayDueEvents(context.Background(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(); event["type"] != models.EventBudgetCreated || event["budgetId"] != added.BudgetID {
+		t.Fatalf("watched event %v, want the creation of %s", event, added.BudgetID)
+	}
+
+	var updated struct {
+		Budget struct {
+			Name    string
+			Version string
+		}
+	}
+	code = call(http.MethodPatch, "/v1/users/u1/budgets/"+added.BudgetID, `{"name":"groceries","version":"1"}`, &updated)
+	if code != http.StatusOK || updated.Budget.Name != "groceries" || updated.Budget.Version != "2" {
+		t.Fatalf("update budget: %d %+v", code, updated)
+	}
+
+	var failure struct {
+		Code    int
+		Message string
+	}
+	code = call(http.MethodDelete, "/v1/users/u1/budgets/"+added.BudgetID+"?version=1", "", &failure)
+	if code != http.StatusBadRequest || failure.Code != int(codes.FailedPrecondition) {
+		t.Fatalf("delete with a stale version: %d %+v", code, failure)
+	}
+	code = call(http.MethodGet, "/v1/users/u1/budgets/"+added.BudgetID+"?userId=u2", "", &failure)
+	if code != http.StatusBadRequest || failure.Code != int(codes.InvalidArgument) {
+		t.Fatalf("query repeating the path: %d %+v", code, failure)
+	}
+	code = call(http.MethodGet, "/v1/users/u1/budgets/000000000000000000000000", "", &failure)
+	if code != http.StatusNotFound || failure.Code != int(codes.NotFound) {
+		t.Fatalf("missing budget: %d %+v", code, failure)
+	}
+
+	var list struct {
+		Budgets []struct{ BudgetID string }
+	}
+	code = call(http.MethodGet, "/v1/users/u1/budgets?namePrefix=groc&pageSize=10", "", &list)
+	if code != http.StatusOK || len(list.Budgets) != 1 || list.Budgets[0].BudgetID != added.BudgetID {
+		t.Fatalf("list budgets: %d %+v", code, list)
+	}
+	var empty struct{}
+	code = call(http.MethodDelete, "/v1/users/u1/budgets/"+added.BudgetID+"?version=2", "", &empty)
+	if code != http.StatusOK {
+		t.Fatalf("delete budget: %d", code)
+	}
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *BudgetServiceServer) ShareBudget(ctx context.Context, req *budgetProto.ShareBudgetRequest) (*budgetProto.GetBudgetResponse, error) {
+	share := models.ShareBudget{
+		UserID:   req.UserId,
+		BudgetID: req.BudgetId,
+		MemberID: req.MemberId,
+		Role:     req.Role,
+		Version:  optionalVersion(req.Version),
+	}
+	if err := validate.Struct(share); err != nil {
+		return nil, err
+	}
+	budget, err := s.BudgetSRV.ShareBudget(ctx, share)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetResponse{
+		Budget: convertToProtoBudget(budget, req.UserId),
+	}, nil
+}
+
+func (s *BudgetServiceServer) RevokeShare(ctx context.Context, req *budgetProto.RevokeShareRequest) (*emptypb.Empty, error) {
+	err := s.BudgetSRV.RevokeShare(ctx, req.UserId, req.BudgetId, req.MemberId, optionalVersion(req.Version))
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BudgetServiceServer) ListMembers(ctx context.Context, req *budgetProto.ListMembersRequest) (*budgetProto.ListMembersResponse, error) {
+	members, err := s.BudgetSRV.ListMembers(ctx, req.UserId, req.BudgetId)
+	if err != nil {
+		return nil, err
+	}
+	protoMembers := make([]*budgetProto.Member, len(members))
+	for i, member := range members {
+		protoMembers[i] = &budgetProto.Member{
+			UserId: member.UserID,
+			Role:   member.Role,
+		}
+		if !member.AddedAt.IsZero() {
+			protoMembers[i].AddedAt = member.AddedAt.UTC().Format(time.RFC3339)
+		}
+	}
+	return &budgetProto.ListMembersResponse{
+		Members: protoMembers,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *BudgetServiceServer) CreateTemplate(ctx context.Context, req *budgetProto.CreateTemplateRequest) (*budgetProto.GetTemplateResponse, error) {
+	createTemplate, err := fromProtoTemplate(req.UserId, req.Name, req.Limit, req.Currency, req.Allocation, req.Categories)
+	if err != nil {
+		return nil, err
+	}
+	if err := validate.Struct(createTemplate); err != nil {
+		return nil, err
+	}
+	template, err := s.BudgetSRV.CreateTemplate(ctx, createTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetTemplateResponse{
+		Template: convertToProtoTemplate(template),
+	}, nil
+}
+
+func (s *BudgetServiceServer) GetTemplate(ctx context.Context, req *budgetProto.GetTemplateRequest) (*budgetProto.GetTemplateResponse, error) {
+	template, err := s.BudgetSRV.GetTemplate(ctx, req.UserId, req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetTemplateResponse{
+		Template: convertToProtoTemplate(template),
+	}, nil
+}
+
+func (s *BudgetServiceServer) ListTemplates(ctx context.Context, req *budgetProto.ListTemplatesRequest) (*budgetProto.ListTemplatesResponse, error) {
+	templates, err := s.BudgetSRV.ListTemplates(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	protoTemplates := make([]*budgetProto.Template, len(templates))
+	for i := range templates {
+		protoTemplates[i] = convertToProtoTemplate(&templates[i])
+	}
+	return &budgetProto.ListTemplatesResponse{
+		Templates: protoTemplates,
+	}, nil
+}
+
+func (s *BudgetServiceServer) UpdateTemplate(ctx context.Context, req *budgetProto.UpdateTemplateRequest) (*budgetProto.GetTemplateResponse, error) {
+	createTemplate, err := fromProtoTemplate(req.UserId, req.Name, req.Limit, req.Currency, req.Allocation, req.Categories)
+	if err != nil {
+		return nil, err
+	}
+	updateTemplate := models.UpdateTemplate{
+		TemplateID:     req.TemplateId,
+		CreateTemplate: createTemplate,
+	}
+	if err := validate.Struct(updateTemplate); err != nil {
+		return nil, err
+	}
+	template, err := s.BudgetSRV.UpdateTemplate(ctx, updateTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetTemplateResponse{
+		Template: convertToProtoTemplate(template),
+	}, nil
+}
+
+func (s *BudgetServiceServer) DeleteTemplate(ctx context.Context, req *budgetProto.DeleteTemplateRequest) (*emptypb.Empty, error) {
+	err := s.BudgetSRV.DeleteTemplate(ctx, req.UserId, req.TemplateId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BudgetServiceServer) SaveBudgetAsTemplate(ctx context.Context, req *budgetProto.SaveBudgetAsTemplateRequest) (*budgetProto.GetTemplateResponse, error) {
+	save := models.SaveBudgetAsTemplate{
+		UserID:   req.UserId,
+		BudgetID: req.BudgetId,
+		Name:     req.Name,
+	}
+	if err := validate.Struct(save); err != nil {
+		return nil, err
+	}
+	template, err := s.BudgetSRV.SaveBudgetAsTemplate(ctx, save)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetTemplateResponse{
+		Template: convertToProtoTemplate(template),
+	}, nil
+}
+
+func (s *BudgetServiceServer) AddBudgetFromTemplate(ctx context.Context, req *budgetProto.AddBudgetFromTemplateRequest) (*budgetProto.GetBudgetResponse, error) {
+	add := models.AddBudgetFromTemplate{
+		UserID:     req.UserId,
+		TemplateID: req.TemplateId,
+		Name:       req.Name,
+		Period:     req.Period,
+		StartDate:  req.Start,
+		EndDate:    req.End,
+		Recurring:  req.Recurring,
+	}
+	if err := validate.Struct(add); err != nil {
+		return nil, err
+	}
+	budget, warnings, err := s.BudgetSRV.AddBudgetFromTemplate(ctx, add)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetResponse{
+		Budget:   convertToProtoBudget(budget, req.UserId),
+		Warnings: warnings,
+	}, nil
+}
+
+func fromProtoTemplate(userID, name string, limit *budgetProto.Money, currency, allocation string,
+	categories []*budgetProto.TemplateCategory) (models.CreateTemplate, error) {
+	templateLimit, err := fromProtoMoney("limit", limit)
+	if err != nil {
+		return models.CreateTemplate{}, err
+	}
+	createTemplate := models.CreateTemplate{
+		UserID:     userID,
+		Name:       name,
+		Limit:      templateLimit,
+		Currency:   currency,
+		Allocation: allocation,
+		Categories: make([]models.TemplateCategory, len(categories)),
+	}
+	for i, c := range categories {
+		categLimit, err := fromProtoMoney("category limit", c.Limit)
+		if err != nil {
+			return models.CreateTemplate{}, err
+		}
+		categ := models.TemplateCategory{
+			Name:  c.Name,
+			Limit: categLimit,
+		}
+		if c.Percent != "" {
+			categ.Percent, err = models.ParsePercent(c.Percent)
+			if err != nil {
+				return models.CreateTemplate{}, apperrors.InvalidArgument("%v", err)
+			}
+		}
+		if c.RolloverMode != "" {
+			rolloverCap, err := fromProtoMoney("rolloverCap", c.RolloverCap)
+			if err != nil {
+				return models.CreateTemplate{}, err
+			}
+			categ.Rollover = &models.Rollover{
+				Mode: c.RolloverMode,
+				Cap:  rolloverCap,
+			}
+		}
+		createTemplate.Categories[i] = categ
+	}
+	return createTemplate, nil
+}
+
+func convertToProtoTemplate(template *models.Template) *budgetProto.Template {
+	protoTemplate := &budgetProto.Template{
+		TemplateId: template.ID,
+		Name:       template.Name,
+		Limit:      toProtoMoney(template.Limit),
+		Currency:   template.Currency,
+		Allocation: template.Allocation,
+		Categories: make([]*budgetProto.TemplateCategory, len(template.Categories)),
+		CreatedAt:  template.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  template.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	for i, c := range template.Categories {
+		categ := &budgetProto.TemplateCategory{
+			Name: c.Name,
+		}
+		if c.Percent != 0 {
+			categ.Percent = c.Percent.String()
+		} else {
+			categ.Limit = toProtoMoney(c.Limit)
+		}
+		if c.Rollover != nil {
+			categ.RolloverMode = c.Rollover.Mode
+			categ.RolloverCap = toProtoMoney(c.Rollover.Cap)
+		}
+		protoTemplate.Categories[i] = categ
+	}
+	return protoTemplate
+}
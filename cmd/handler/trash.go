@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) ListDeleted(ctx context.Context, req *budgetProto.ListDeletedRequest) (*budgetProto.ListDeletedResponse, error) {
+	budgets, categories, err := s.BudgetSRV.ListDeleted(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	protoCategories := make([]*budgetProto.DeletedCategory, len(categories))
+	for i, deleted := range categories {
+		protoCategories[i] = &budgetProto.DeletedCategory{
+			BudgetId: deleted.BudgetID,
+			Category: convertToProtoCategories([]models.Category{deleted.Category})[0],
+		}
+	}
+	return &budgetProto.ListDeletedResponse{
+		Budgets:    convertToProtoBudgets(budgets, req.UserId),
+		Categories: protoCategories,
+	}, nil
+}
+
+func (s *BudgetServiceServer) RestoreBudget(ctx context.Context, req *budgetProto.RestoreBudgetRequest) (*budgetProto.GetBudgetResponse, error) {
+	budget, err := s.BudgetSRV.RestoreBudget(ctx, req.UserId, req.BudgetId, optionalVersion(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetResponse{
+		Budget: convertToProtoBudget(budget, req.UserId),
+	}, nil
+}
+
+func (s *BudgetServiceServer) RestoreCategory(ctx context.Context, req *budgetProto.RestoreCategoryRequest) (*budgetProto.GetBudgetResponse, error) {
+	budget, warnings, err := s.BudgetSRV.RestoreCategory(ctx, req.UserId, req.BudgetId, req.CategoryId, optionalVersion(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return &budgetProto.GetBudgetResponse{
+		Budget:   convertToProtoBudget(budget, req.UserId),
+		Warnings: warnings,
+	}, nil
+}
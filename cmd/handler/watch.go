@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
+)
+
+func (s *BudgetServiceServer) WatchBudgets(req *budgetProto.WatchBudgetsRequest, stream budgetProto.BudgetService_WatchBudgetsServer) error {
+	watch := models.WatchBudgets{
+		UserID:      req.UserId,
+		ResumeToken: req.ResumeToken,
+	}
+	if err := validate.Struct(watch); err != nil {
+		return err
+	}
+	return s.BudgetSRV.WatchBudgets(stream.Context(), watch.UserID, watch.ResumeToken, func(event models.Event, resumeToken string) error {
+		changes := make([]*budgetProto.FieldChange, len(event.Changes))
+		for i, change := range event.Changes {
+			changes[i] = &budgetProto.FieldChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+		return stream.Send(&budgetProto.BudgetEvent{
+			EventId:     event.ID,
+			Type:        event.Type,
+			BudgetId:    event.BudgetID,
+			CategoryId:  event.CategoryID,
+			OccurredAt:  event.OccurredAt.UTC().Format(time.RFC3339Nano),
+			Changes:     changes,
+			Threshold:   int32(event.Threshold),
+			ResumeToken: resumeToken,
+		})
+	})
+}
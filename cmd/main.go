@@ -2,39 +2,203 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/justIGreK/MoneyKeeper-Budget/cmd/handler"
-	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/auth"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/config"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/events"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/exchange"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/health"
 	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
 	"github.com/justIGreK/MoneyKeeper-Budget/pkg/client"
+	budgetProto "github.com/justIGreK/MoneyKeeper-Budget/pkg/go/budget"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
-	ctx := context.Background()
+	configPath := flag.String("config", os.Getenv("BUDGET_CONFIG_FILE"), "path to the YAML config file")
+	flag.Parse()
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	if flag.NArg() > 0 {
+		if err := runAdmin(ctx, cfg, flag.Args()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
-	user, err := client.NewUserClient("localhost:50052")
+	userCreds, err := cfg.UserService.TLS.Credentials()
+	if err != nil {
+		log.Fatal(err)
+	}
+	user, err := client.NewUserClient(cfg.UserService.Addr, userCreds, cfg.UserService.Timeout)
 	if err != nil {
 		log.Fatal(err)
 	}
-	db := repository.CreateMongoClient(ctx)
-	budgetDB := repository.NewBudgetRepository(db)
-	budgetSRV := service.NewBudgetService(budgetDB, user)
-	lis, err := net.Listen("tcp", ":50051")
+	defer user.Close()
+	storage := newStorage(ctx, cfg)
+	defer storage.close()
+	rates, err := newRateProvider(cfg.Exchange)
+	if err != nil {
+		log.Fatal(err)
+	}
+	broker := events.NewBroker(cfg.Events.WatchHistory)
+	if !cfg.Events.SingleInstance {
+		log.Println("WARNING: events.single_instance is off; WatchBudgets only streams the events relayed by this instance, so watchers miss events and can not resume on other instances")
+	}
+	budgetSRV := service.NewBudgetService(storage.budgets, storage.expenses, storage.templates, storage.audit, storage.outbox, storage.tx, broker, storage.locks, user, rates, cfg.Exchange.DefaultCurrency)
+	lis, err := net.Listen("tcp", cfg.Server.ListenAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	grpcServer := grpc.NewServer()
+	unary := []grpc.UnaryServerInterceptor{handler.UnaryRequestInfoInterceptor, handler.UnaryErrorInterceptor}
+	stream := []grpc.StreamServerInterceptor{handler.StreamRequestInfoInterceptor, handler.StreamErrorInterceptor}
+	if cfg.Auth.Enabled {
+		verifier := newVerifier(cfg.Auth)
+		unary = append(unary, handler.UnaryAuthInterceptor(verifier))
+		stream = append(stream, handler.StreamAuthInterceptor(verifier))
+	} else {
+		log.Println("WARNING: authentication is disabled, any caller can act as any user by setting the userId of requests")
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	serverCreds, err := cfg.Server.TLS.Credentials()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if serverCreds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(serverCreds))
+	}
+	grpcServer := grpc.NewServer(serverOpts...)
 
+	gateway, err := handler.NewRESTGateway(budgetSRV, unary, stream)
+	if err != nil {
+		log.Fatal(err)
+	}
 	handler := handler.NewHandler(grpcServer, budgetSRV)
 	handler.RegisterServices()
 	reflection.Register(grpcServer)
 
-	log.Printf("Starting gRPC server on :50051")
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	checker := health.NewChecker(healthServer, cfg.Server.HealthCheckInterval, budgetProto.BudgetService_ServiceDesc.ServiceName)
+	if storage.ping != nil {
+		checker.Add(cfg.Storage.Driver, storage.ping)
+	}
+	checker.Add("user-service", user.Ping)
+	go checker.Run(ctx)
+	go budgetSRV.RunRenewal(ctx, cfg.Jobs.RenewalInterval)
+	go budgetSRV.RunPurge(ctx, cfg.Jobs.PurgeInterval, cfg.Jobs.TrashRetention)
+	relay := service.NewOutboxRelay(storage.outbox, broker, cfg.Events.BatchSize, cfg.Events.MaxAttempts, cfg.Events.RetryBackoff)
+	go relay.Run(ctx, cfg.Events.RelayInterval)
+
+	serveErr := make(chan error, 2)
+	go func() {
+		log.Printf("Starting gRPC server on %s", cfg.Server.ListenAddr)
+		serveErr <- grpcServer.Serve(lis)
+	}()
+	var httpServer *http.Server
+	if cfg.Server.HTTPListenAddr != "" {
+		tlsCfg, err := cfg.Server.TLS.Config()
+		if err != nil {
+			log.Fatal(err)
+		}
+		httpServer = &http.Server{
+			Addr:              cfg.Server.HTTPListenAddr,
+			Handler:           gateway,
+			TLSConfig:         tlsCfg,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			log.Printf("Starting HTTP gateway on %s", cfg.Server.HTTPListenAddr)
+			if tlsCfg != nil {
+				serveErr <- httpServer.ListenAndServeTLS("", "")
+			} else {
+				serveErr <- httpServer.ListenAndServe()
+			}
+		}()
+	}
+
+	select {
+	case err := <-serveErr:
+		log.Printf("failed to serve: %v", err)
+		return
+	case <-ctx.Done():
+	}
+	log.Println("Shutting down gRPC server")
+	healthServer.Shutdown()
+	// both servers drain in parallel, main returns and closes the storage only
+	// once they are done
+	var wg sync.WaitGroup
+	if httpServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shutdownHTTP(httpServer, cfg.Server.ShutdownTimeout)
+		}()
+	}
+	shutdown(grpcServer, cfg.Server.ShutdownTimeout)
+	wg.Wait()
+}
+
+// shutdownHTTP gives in-flight requests the drain timeout to finish. Watch
+// streams only end with their request, so they are closed when it expires.
+func shutdownHTTP(server *http.Server, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("stopping HTTP gateway: %v", err)
+		server.Close()
+	}
+}
+
+// shutdown lets in-flight RPCs finish and forcibly closes the remaining
+// connections once the drain timeout expires.
+func shutdown(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Println("drain timeout expired, stopping server")
+		server.Stop()
+	}
+}
+
+func newVerifier(cfg config.Auth) *auth.Verifier {
+	keys := make(map[string][]byte, len(cfg.Keys))
+	for id, secret := range cfg.Keys {
+		keys[id] = []byte(secret)
+	}
+	return auth.NewVerifier(keys, cfg.Issuer, cfg.Audience, cfg.AdminRole)
+}
+
+// newRateProvider loads the configured rates file. Without one, conversions
+// between currencies fail with FailedPrecondition.
+func newRateProvider(cfg config.Exchange) (service.ExchangeRateProvider, error) {
+	if cfg.RatesFile == "" {
+		return &exchange.StaticProvider{}, nil
 	}
+	return exchange.LoadFile(cfg.RatesFile)
 }
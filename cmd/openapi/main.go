@@ -0,0 +1,22 @@
+// Command openapi writes the OpenAPI document of the HTTP/JSON gateway.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/cmd/handler"
+)
+
+func main() {
+	out := flag.String("out", "api/openapi/budget.json", "file to write the document to")
+	flag.Parse()
+	doc, err := handler.OpenAPI()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, append(doc, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/config"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository/memory"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type storage struct {
+	budgets   service.BudgetRepository
+	expenses  service.ExpenseRepository
+	templates service.TemplateRepository
+	audit     service.AuditRepository
+	outbox    service.OutboxRepository
+	tx        service.Transactor
+	locks     service.Locker
+	// ping is nil for storages without an external dependency
+	ping  func(ctx context.Context) error
+	close func()
+}
+
+func newStorage(ctx context.Context, cfg *config.Config) storage {
+	if cfg.Storage.Driver == config.StorageMemory {
+		log.Println("Using in-memory storage, data will be lost on restart")
+		return storage{
+			budgets:   memory.NewBudgetRepository(),
+			expenses:  memory.NewExpenseRepository(),
+			templates: memory.NewTemplateRepository(),
+			audit:     memory.NewAuditRepository(),
+			outbox:    memory.NewOutboxRepository(),
+			tx:        memory.NewTransactor(),
+			locks:     memory.NewLocker(),
+			close:     func() {},
+		}
+	}
+
+	mongoClient := repository.CreateMongoClient(ctx, cfg.Mongo)
+	if err := repository.RequireTransactions(ctx, mongoClient); err != nil {
+		log.Fatal(err)
+	}
+	db := mongoClient.Database(cfg.Mongo.Database)
+	if err := repository.MigrateMoneyToCents(ctx, db, cfg.Mongo.BudgetCollection, cfg.Mongo.ExpenseCollection); err != nil {
+		log.Fatalf("failed to migrate money fields: %v", err)
+	}
+	if err := repository.EnsureIndexes(ctx, db, cfg.Mongo.BudgetCollection, cfg.Mongo.AuditCollection, cfg.Mongo.OutboxCollection, cfg.Mongo.TemplateCollection); err != nil {
+		log.Fatalf("failed to create indexes: %v", err)
+	}
+	return storage{
+		budgets:   repository.NewBudgetRepository(db, cfg.Mongo.BudgetCollection),
+		expenses:  repository.NewExpenseRepository(db, cfg.Mongo.ExpenseCollection),
+		templates: repository.NewTemplateRepository(db, cfg.Mongo.TemplateCollection),
+		audit:     repository.NewAuditRepository(db, cfg.Mongo.AuditCollection),
+		outbox:    repository.NewOutboxRepository(db, cfg.Mongo.OutboxCollection),
+		tx:        repository.NewTransactor(mongoClient),
+		locks:     repository.NewLocker(db, cfg.Mongo.LockCollection),
+		ping: func(ctx context.Context) error {
+			return mongoClient.Ping(ctx, readpref.Primary())
+		},
+		close: func() {
+			disconnectCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+			defer cancel()
+			if err := mongoClient.Disconnect(disconnectCtx); err != nil {
+				log.Printf("failed to disconnect from MongoDB: %v", err)
+			}
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of domain errors. Use errors.Is against these to classify an error
+// returned by the service or repository layers.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrConflict           = errors.New("conflict")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+)
+
+type Error struct {
+	kind error
+	msg  string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+func newError(kind error, format string, args ...any) error {
+	return &Error{kind: kind, msg: fmt.Sprintf(format, args...)}
+}
+
+func NotFound(format string, args ...any) error {
+	return newError(ErrNotFound, format, args...)
+}
+
+func InvalidArgument(format string, args ...any) error {
+	return newError(ErrInvalidArgument, format, args...)
+}
+
+func AlreadyExists(format string, args ...any) error {
+	return newError(ErrAlreadyExists, format, args...)
+}
+
+func Conflict(format string, args ...any) error {
+	return newError(ErrConflict, format, args...)
+}
+
+func FailedPrecondition(format string, args ...any) error {
+	return newError(ErrFailedPrecondition, format, args...)
+}
+
+func Unavailable(format string, args ...any) error {
+	return newError(ErrUnavailable, format, args...)
+}
+
+func Unauthenticated(format string, args ...any) error {
+	return newError(ErrUnauthenticated, format, args...)
+}
+
+func PermissionDenied(format string, args ...any) error {
+	return newError(ErrPermissionDenied, format, args...)
+}
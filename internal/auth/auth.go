@@ -0,0 +1,97 @@
+// Package auth verifies the bearer tokens callers identify themselves with.
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+)
+
+// Identity is the verified caller of a request.
+type Identity struct {
+	UserID string
+	// Admin callers may act on behalf of any user.
+	Admin bool
+}
+
+type identityKey struct{}
+
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the caller of the request and false if it was not
+// authenticated.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// leeway absorbs clock skew between the token issuer and this service.
+const leeway = 30 * time.Second
+
+// Claims are the claims read from a token. The subject is the user ID.
+type Claims struct {
+	Roles []string `json:"roles,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Verifier checks HMAC signed JWTs against a local key set. Tokens name
+// their key with the kid header, which may be left out when there is only
+// one key.
+type Verifier struct {
+	keys      map[string][]byte
+	issuer    string
+	audience  string
+	adminRole string
+}
+
+func NewVerifier(keys map[string][]byte, issuer, audience, adminRole string) *Verifier {
+	return &Verifier{keys: keys, issuer: issuer, audience: audience, adminRole: adminRole}
+}
+
+// Verify returns the identity of a valid token. Tokens must expire and, if
+// configured, come from the issuer for the audience.
+func (v *Verifier) Verify(token string) (Identity, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+	if v.audience != "" {
+		opts = append(opts, jwt.WithAudience(v.audience))
+	}
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, v.key, opts...)
+	if err != nil {
+		return Identity{}, apperrors.Unauthenticated("invalid token: %v", err)
+	}
+	if claims.Subject == "" {
+		return Identity{}, apperrors.Unauthenticated("invalid token: subject is missing")
+	}
+	return Identity{
+		UserID: claims.Subject,
+		Admin:  v.adminRole != "" && slices.Contains(claims.Roles, v.adminRole),
+	}, nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, nil
+		}
+	}
+	key, ok := v.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	return key, nil
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestVerify(t *testing.T) {
+	key1 := []byte("0123456789abcdef0123456789abcdef")
+	key2 := []byte("fedcba9876543210fedcba9876543210")
+	oneKey := NewVerifier(map[string][]byte{"k1": key1}, "", "", "admin")
+	twoKeys := NewVerifier(map[string][]byte{"k1": key1, "k2": key2}, "", "", "admin")
+	scoped := NewVerifier(map[string][]byte{"k1": key1}, "issuer", "budget", "")
+
+	claims := func(subject string, exp time.Duration, roles ...string) Claims {
+		return Claims{Roles: roles, RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(exp)),
+		}}
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any, claims Claims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	noExpiry := claims("u1", 0)
+	noExpiry.ExpiresAt = nil
+	fromIssuer := claims("u1", time.Hour)
+	fromIssuer.Issuer = "issuer"
+	fromIssuer.Audience = jwt.ClaimStrings{"budget"}
+	otherAudience := fromIssuer
+	otherAudience.Audience = jwt.ClaimStrings{"other"}
+	otherIssuer := fromIssuer
+	otherIssuer.Issuer = "other"
+
+	tests := []struct {
+		name     string
+		verifier *Verifier
+		token    string
+		want     Identity
+		err      bool
+	}{
+		{"valid", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, claims("u1", time.Hour)), Identity{UserID: "u1"}, false},
+		{"HS512", oneKey, sign(jwt.SigningMethodHS512, "k1", key1, claims("u1", time.Hour)), Identity{UserID: "u1"}, false},
+		{"admin", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, claims("u1", time.Hour, "user", "admin")), Identity{UserID: "u1", Admin: true}, false},
+		{"other role", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, claims("u1", time.Hour, "user")), Identity{UserID: "u1"}, false},
+		{"alg none", oneKey, sign(jwt.SigningMethodNone, "k1", jwt.UnsafeAllowNoneSignatureType, claims("u1", time.Hour)), Identity{}, true},
+		{"wrong key", oneKey, sign(jwt.SigningMethodHS256, "k1", key2, claims("u1", time.Hour)), Identity{}, true},
+		{"kid left out with one key", oneKey, sign(jwt.SigningMethodHS256, "", key1, claims("u1", time.Hour)), Identity{UserID: "u1"}, false},
+		{"kid left out with two keys", twoKeys, sign(jwt.SigningMethodHS256, "", key1, claims("u1", time.Hour)), Identity{}, true},
+		{"second kid", twoKeys, sign(jwt.SigningMethodHS256, "k2", key2, claims("u2", time.Hour)), Identity{UserID: "u2"}, false},
+		{"unknown kid", twoKeys, sign(jwt.SigningMethodHS256, "k3", key1, claims("u1", time.Hour)), Identity{}, true},
+		{"expired", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, claims("u1", -time.Hour)), Identity{}, true},
+		{"expired within leeway", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, claims("u1", -leeway/2)), Identity{UserID: "u1"}, false},
+		{"no expiry", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, noExpiry), Identity{}, true},
+		{"no subject", oneKey, sign(jwt.SigningMethodHS256, "k1", key1, claims("", time.Hour)), Identity{}, true},
+		{"issuer and audience", scoped, sign(jwt.SigningMethodHS256, "k1", key1, fromIssuer), Identity{UserID: "u1"}, false},
+		{"other issuer", scoped, sign(jwt.SigningMethodHS256, "k1", key1, otherIssuer), Identity{}, true},
+		{"other audience", scoped, sign(jwt.SigningMethodHS256, "k1", key1, otherAudience), Identity{}, true},
+		{"issuer missing", scoped, sign(jwt.SigningMethodHS256, "k1", key1, claims("u1", time.Hour)), Identity{}, true},
+		{"admin role not configured", scoped, sign(jwt.SigningMethodHS256, "k1", key1, Claims{Roles: []string{""}, RegisteredClaims: fromIssuer.RegisteredClaims}), Identity{UserID: "u1"}, false},
+		{"garbage", oneKey, "garbage", Identity{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.verifier.Verify(tt.token)
+			if (err != nil) != tt.err {
+				t.Fatalf("Verify() error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Fatalf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
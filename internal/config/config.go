@@ -0,0 +1,392 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	Server      Server      `yaml:"server"`
+	Storage     Storage     `yaml:"storage"`
+	Mongo       Mongo       `yaml:"mongo"`
+	UserService UserService `yaml:"user_service"`
+	Exchange    Exchange    `yaml:"exchange"`
+	Jobs        Jobs        `yaml:"jobs"`
+	Events      Events      `yaml:"events"`
+	Auth        Auth        `yaml:"auth"`
+}
+
+type Server struct {
+	ListenAddr string `yaml:"listen_addr"`
+	// HTTPListenAddr is where the HTTP/JSON gateway listens, empty disables
+	// it. It uses the same TLS settings as the gRPC listener.
+	HTTPListenAddr      string        `yaml:"http_listen_addr"`
+	TLS                 ServerTLS     `yaml:"tls"`
+	ShutdownTimeout     time.Duration `yaml:"shutdown_timeout"`
+	HealthCheckInterval time.Duration `yaml:"health_check_interval"`
+}
+
+const (
+	StorageMongo  = "mongo"
+	StorageMemory = "memory"
+)
+
+type Storage struct {
+	// Driver selects the repository implementation: "mongo" or "memory".
+	Driver string `yaml:"driver"`
+}
+
+type Mongo struct {
+	URI                string        `yaml:"uri"`
+	Database           string        `yaml:"database"`
+	BudgetCollection   string        `yaml:"budget_collection"`
+	ExpenseCollection  string        `yaml:"expense_collection"`
+	LockCollection     string        `yaml:"lock_collection"`
+	AuditCollection    string        `yaml:"audit_collection"`
+	OutboxCollection   string        `yaml:"outbox_collection"`
+	TemplateCollection string        `yaml:"template_collection"`
+	ConnectTimeout     time.Duration `yaml:"connect_timeout"`
+}
+
+type UserService struct {
+	Addr    string        `yaml:"addr"`
+	Timeout time.Duration `yaml:"timeout"`
+	TLS     ClientTLS     `yaml:"tls"`
+}
+
+type Exchange struct {
+	// DefaultCurrency is used for budgets created without a currency.
+	DefaultCurrency string `yaml:"default_currency"`
+	// RatesFile is an optional YAML/JSON file for the static rate provider.
+	RatesFile string `yaml:"rates_file"`
+}
+
+type Jobs struct {
+	// RenewalInterval is how often ended recurring budgets are renewed.
+	RenewalInterval time.Duration `yaml:"renewal_interval"`
+	// PurgeInterval is how often the trash is emptied of expired entries.
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+	// TrashRetention is how long deleted budgets and categories can be
+	// restored before they are removed for good.
+	TrashRetention time.Duration `yaml:"trash_retention"`
+}
+
+type Events struct {
+	// RelayInterval is how often the outbox is polled for events to publish.
+	RelayInterval time.Duration `yaml:"relay_interval"`
+	BatchSize     int           `yaml:"batch_size"`
+	// MaxAttempts is how often an event is tried before it is dead-lettered.
+	MaxAttempts int `yaml:"max_attempts"`
+	// RetryBackoff is the delay after the first failed attempt, it doubles
+	// with every further one.
+	RetryBackoff time.Duration `yaml:"retry_backoff"`
+	// WatchHistory is how many published events are kept for watchers that
+	// resume after a reconnect.
+	WatchHistory int `yaml:"watch_history"`
+	// SingleInstance states that only one instance of the server runs.
+	// Events reach the watchers of the instance that relayed them and resume
+	// tokens are only known to the instance that issued them, so with more
+	// instances WatchBudgets misses events; the server warns about it at
+	// startup.
+	SingleInstance bool `yaml:"single_instance"`
+}
+
+type Auth struct {
+	// Enabled requires BudgetService calls to carry a bearer token. It is on
+	// by default; without it the userId fields of requests are trusted.
+	Enabled bool `yaml:"enabled"`
+	// Keys are the HMAC secrets tokens may be signed with, by key ID.
+	Keys map[string]string `yaml:"keys"`
+	// Issuer and Audience, if set, must match the iss and aud claims.
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// AdminRole in the roles claim lets callers act for any user.
+	AdminRole string `yaml:"admin_role"`
+}
+
+// minKeySize is the smallest secret accepted for HMAC signatures.
+const minKeySize = 32
+
+func Default() Config {
+	return Config{
+		Server: Server{
+			ListenAddr:          ":50051",
+			HTTPListenAddr:      ":8080",
+			ShutdownTimeout:     15 * time.Second,
+			HealthCheckInterval: 10 * time.Second,
+		},
+		Storage: Storage{
+			Driver: StorageMongo,
+		},
+		Mongo: Mongo{
+			// must be a replica set, the server refuses to start otherwise
+			URI:                "mongodb://localhost:27019",
+			Database:           "mkbudgets",
+			BudgetCollection:   "budgets",
+			ExpenseCollection:  "expenses",
+			LockCollection:     "locks",
+			AuditCollection:    "budget_audit",
+			OutboxCollection:   "outbox",
+			TemplateCollection: "budget_templates",
+			ConnectTimeout:     10 * time.Second,
+		},
+		UserService: UserService{
+			Addr:    "localhost:50052",
+			Timeout: 5 * time.Second,
+		},
+		Exchange: Exchange{
+			DefaultCurrency: "USD",
+		},
+		Jobs: Jobs{
+			RenewalInterval: time.Minute,
+			PurgeInterval:   time.Hour,
+			TrashRetention:  30 * 24 * time.Hour,
+		},
+		Events: Events{
+			RelayInterval:  time.Second,
+			BatchSize:      100,
+			MaxAttempts:    10,
+			RetryBackoff:   time.Second,
+			WatchHistory:   1000,
+			SingleInstance: true,
+		},
+		Auth: Auth{
+			Enabled:   true,
+			AdminRole: "admin",
+		},
+	}
+}
+
+// Load builds the configuration from the defaults, the optional YAML file at
+// path and finally the BUDGET_* environment variables, in that order of
+// precedence.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func (c *Config) applyEnv() error {
+	e := envReader{}
+	e.string("BUDGET_LISTEN_ADDR", &c.Server.ListenAddr)
+	e.string("BUDGET_HTTP_LISTEN_ADDR", &c.Server.HTTPListenAddr)
+	e.string("BUDGET_TLS_CERT_FILE", &c.Server.TLS.CertFile)
+	e.string("BUDGET_TLS_KEY_FILE", &c.Server.TLS.KeyFile)
+	e.string("BUDGET_TLS_CLIENT_CA_FILE", &c.Server.TLS.ClientCAFile)
+	e.duration("BUDGET_SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout)
+	e.duration("BUDGET_HEALTH_CHECK_INTERVAL", &c.Server.HealthCheckInterval)
+
+	e.string("BUDGET_STORAGE_DRIVER", &c.Storage.Driver)
+
+	e.string("BUDGET_MONGO_URI", &c.Mongo.URI)
+	e.string("BUDGET_MONGO_DATABASE", &c.Mongo.Database)
+	e.string("BUDGET_MONGO_BUDGET_COLLECTION", &c.Mongo.BudgetCollection)
+	e.string("BUDGET_MONGO_EXPENSE_COLLECTION", &c.Mongo.ExpenseCollection)
+	e.string("BUDGET_MONGO_LOCK_COLLECTION", &c.Mongo.LockCollection)
+	e.string("BUDGET_MONGO_AUDIT_COLLECTION", &c.Mongo.AuditCollection)
+	e.string("BUDGET_MONGO_OUTBOX_COLLECTION", &c.Mongo.OutboxCollection)
+	e.string("BUDGET_MONGO_TEMPLATE_COLLECTION", &c.Mongo.TemplateCollection)
+	e.duration("BUDGET_MONGO_CONNECT_TIMEOUT", &c.Mongo.ConnectTimeout)
+
+	e.string("BUDGET_USER_SERVICE_ADDR", &c.UserService.Addr)
+	e.duration("BUDGET_USER_SERVICE_TIMEOUT", &c.UserService.Timeout)
+	e.bool("BUDGET_USER_SERVICE_TLS", &c.UserService.TLS.Enabled)
+	e.string("BUDGET_USER_SERVICE_CA_FILE", &c.UserService.TLS.CAFile)
+	e.string("BUDGET_USER_SERVICE_SERVER_NAME", &c.UserService.TLS.ServerName)
+
+	e.string("BUDGET_DEFAULT_CURRENCY", &c.Exchange.DefaultCurrency)
+	e.string("BUDGET_EXCHANGE_RATES_FILE", &c.Exchange.RatesFile)
+
+	e.duration("BUDGET_RENEWAL_INTERVAL", &c.Jobs.RenewalInterval)
+	e.duration("BUDGET_PURGE_INTERVAL", &c.Jobs.PurgeInterval)
+	e.duration("BUDGET_TRASH_RETENTION", &c.Jobs.TrashRetention)
+
+	e.duration("BUDGET_EVENTS_RELAY_INTERVAL", &c.Events.RelayInterval)
+	e.int("BUDGET_EVENTS_BATCH_SIZE", &c.Events.BatchSize)
+	e.int("BUDGET_EVENTS_MAX_ATTEMPTS", &c.Events.MaxAttempts)
+	e.duration("BUDGET_EVENTS_RETRY_BACKOFF", &c.Events.RetryBackoff)
+	e.int("BUDGET_EVENTS_WATCH_HISTORY", &c.Events.WatchHistory)
+	e.bool("BUDGET_EVENTS_SINGLE_INSTANCE", &c.Events.SingleInstance)
+
+	e.bool("BUDGET_AUTH_ENABLED", &c.Auth.Enabled)
+	e.keys("BUDGET_AUTH_KEY_", &c.Auth.Keys)
+	e.string("BUDGET_AUTH_ISSUER", &c.Auth.Issuer)
+	e.string("BUDGET_AUTH_AUDIENCE", &c.Auth.Audience)
+	e.string("BUDGET_AUTH_ADMIN_ROLE", &c.Auth.AdminRole)
+	return errors.Join(e.errs...)
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Server.ListenAddr == "" {
+		errs = append(errs, errors.New("server.listen_addr is required"))
+	}
+	if (c.Server.TLS.CertFile == "") != (c.Server.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("server.tls.cert_file and server.tls.key_file must be set together"))
+	}
+	if c.Server.TLS.ClientCAFile != "" && c.Server.TLS.CertFile == "" {
+		errs = append(errs, errors.New("server.tls.client_ca_file requires server TLS to be enabled"))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+	if c.Server.HealthCheckInterval <= 0 {
+		errs = append(errs, errors.New("server.health_check_interval must be positive"))
+	}
+	if c.Storage.Driver != StorageMongo && c.Storage.Driver != StorageMemory {
+		errs = append(errs, fmt.Errorf("storage.driver must be %q or %q, got %q", StorageMongo, StorageMemory, c.Storage.Driver))
+	}
+	if c.Mongo.URI == "" {
+		errs = append(errs, errors.New("mongo.uri is required"))
+	}
+	if c.Mongo.Database == "" {
+		errs = append(errs, errors.New("mongo.database is required"))
+	}
+	if c.Mongo.BudgetCollection == "" || c.Mongo.ExpenseCollection == "" || c.Mongo.LockCollection == "" || c.Mongo.AuditCollection == "" || c.Mongo.OutboxCollection == "" || c.Mongo.TemplateCollection == "" {
+		errs = append(errs, errors.New("mongo collection names must not be empty"))
+	}
+	if c.Mongo.ConnectTimeout <= 0 {
+		errs = append(errs, errors.New("mongo.connect_timeout must be positive"))
+	}
+	if c.UserService.Addr == "" {
+		errs = append(errs, errors.New("user_service.addr is required"))
+	}
+	if c.UserService.Timeout <= 0 {
+		errs = append(errs, errors.New("user_service.timeout must be positive"))
+	}
+	if !models.IsCurrency(c.Exchange.DefaultCurrency) {
+		errs = append(errs, fmt.Errorf("exchange.default_currency %q is not an ISO 4217 code", c.Exchange.DefaultCurrency))
+	} else if !models.HasCents(c.Exchange.DefaultCurrency) {
+		errs = append(errs, fmt.Errorf("exchange.default_currency %q does not have two decimal places", c.Exchange.DefaultCurrency))
+	}
+	if c.Jobs.RenewalInterval <= 0 {
+		errs = append(errs, errors.New("jobs.renewal_interval must be positive"))
+	}
+	if c.Jobs.PurgeInterval <= 0 {
+		errs = append(errs, errors.New("jobs.purge_interval must be positive"))
+	}
+	if c.Jobs.TrashRetention <= 0 {
+		errs = append(errs, errors.New("jobs.trash_retention must be positive"))
+	}
+	if c.Events.RelayInterval <= 0 {
+		errs = append(errs, errors.New("events.relay_interval must be positive"))
+	}
+	if c.Events.BatchSize <= 0 {
+		errs = append(errs, errors.New("events.batch_size must be positive"))
+	}
+	if c.Events.MaxAttempts <= 0 {
+		errs = append(errs, errors.New("events.max_attempts must be positive"))
+	}
+	if c.Events.RetryBackoff <= 0 {
+		errs = append(errs, errors.New("events.retry_backoff must be positive"))
+	}
+	if c.Events.WatchHistory <= 0 {
+		errs = append(errs, errors.New("events.watch_history must be positive"))
+	}
+	if c.Auth.Enabled && len(c.Auth.Keys) == 0 {
+		errs = append(errs, errors.New("auth.keys must not be empty when auth is enabled, set auth.enabled to false to run without authentication"))
+	}
+	for id, secret := range c.Auth.Keys {
+		if len(secret) < minKeySize {
+			errs = append(errs, fmt.Errorf("auth key %q must be at least %d bytes long", id, minKeySize))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+type envReader struct {
+	errs []error
+}
+
+func (e *envReader) string(key string, dst *string) {
+	if v, ok := os.LookupEnv(key); ok {
+		*dst = v
+	}
+}
+
+func (e *envReader) duration(key string, dst *time.Duration) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: %w", key, err))
+		return
+	}
+	*dst = d
+}
+
+func (e *envReader) bool(key string, dst *bool) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: %w", key, err))
+		return
+	}
+	*dst = b
+}
+
+func (e *envReader) int(key string, dst *int) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: %w", key, err))
+		return
+	}
+	*dst = n
+}
+
+// keys reads one key per variable, the key ID following prefix in the name.
+// The value is taken as is, so secrets may contain any character. Keys set
+// this way are added to the configured ones, replacing those of the same ID.
+func (e *envReader) keys(prefix string, dst *map[string]string) {
+	if _, ok := os.LookupEnv("BUDGET_AUTH_KEYS"); ok {
+		e.errs = append(e.errs, fmt.Errorf("BUDGET_AUTH_KEYS is no longer read, set each key as %s<id>=<secret>", prefix))
+	}
+	for _, env := range os.Environ() {
+		name, secret, _ := strings.Cut(env, "=")
+		id, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+		if id == "" {
+			e.errs = append(e.errs, fmt.Errorf("%s: the key ID is missing from the name", name))
+			continue
+		}
+		if *dst == nil {
+			*dst = map[string]string{}
+		}
+		(*dst)[id] = secret
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestAuthKeysFromEnv(t *testing.T) {
+	const (
+		base64Secret = "c2VjcmV0LCB3aXRoIGEgY29tbWEgYW5kIHBhZGRpbmc="
+		rawSecret    = "a,b=c d;e"
+	)
+	tests := []struct {
+		name       string
+		configured map[string]string
+		env        map[string]string
+		want       map[string]string
+		err        bool
+	}{
+		{
+			name:       "no variables",
+			configured: map[string]string{"file": "secret"},
+			want:       map[string]string{"file": "secret"},
+		},
+		{
+			name: "secrets are taken as is",
+			env:  map[string]string{"BUDGET_AUTH_KEY_dev": base64Secret, "BUDGET_AUTH_KEY_Prod-2": rawSecret},
+			want: map[string]string{"dev": base64Secret, "Prod-2": rawSecret},
+		},
+		{
+			name:       "added to the configured keys",
+			configured: map[string]string{"file": "secret", "dev": "old"},
+			env:        map[string]string{"BUDGET_AUTH_KEY_dev": "new"},
+			want:       map[string]string{"file": "secret", "dev": "new"},
+		},
+		{
+			name: "missing key ID",
+			env:  map[string]string{"BUDGET_AUTH_KEY_": "secret"},
+			err:  true,
+		},
+		{
+			name: "old list variable",
+			env:  map[string]string{"BUDGET_AUTH_KEYS": "dev=secret"},
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			cfg := Default()
+			cfg.Auth.Keys = maps.Clone(tt.configured)
+			err := cfg.applyEnv()
+			if (err != nil) != tt.err {
+				t.Fatalf("applyEnv() error = %v, want error %v", err, tt.err)
+			}
+			if !tt.err && !maps.Equal(cfg.Auth.Keys, tt.want) {
+				t.Fatalf("keys %v, want %v", cfg.Auth.Keys, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Package events provides the in-process broker the outbox relay publishes
+// budget events to.
+package events
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// Broker fans published events out to its subscribers within the process.
+// It retains the most recent events, so subscribers that reconnect can
+// resume where they left off. It is meant for single instance deployments.
+type Broker struct {
+	mu          sync.Mutex
+	epoch       string
+	seq         uint64
+	retain      int
+	history     []models.WatchEvent
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	events chan models.WatchEvent
+	match  func(models.Event) bool
+}
+
+// NewBroker returns a broker retaining at least the last retain events.
+func NewBroker(retain int) *Broker {
+	return &Broker{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		retain:      retain,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Subscribe returns the events matching match that are published from now on
+// and, with after set, the retained ones published since then. Resuming
+// after an event that is no longer retained, or was published by another
+// process, fails with FailedPrecondition.
+func (b *Broker) Subscribe(after *models.FeedPosition, buffer int, match func(models.Event) bool) (*models.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var replay []models.WatchEvent
+	if after != nil {
+		if after.Epoch != b.epoch || after.Seq > b.seq || b.seq-after.Seq > uint64(len(b.history)) {
+			return nil, apperrors.FailedPrecondition("resume token has expired")
+		}
+		for _, event := range b.history[len(b.history)-int(b.seq-after.Seq):] {
+			if match(event.Event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+	sub := &subscriber{events: make(chan models.WatchEvent, buffer), match: match}
+	b.subscribers[sub] = struct{}{}
+	return &models.Subscription{
+		Position: models.FeedPosition{Epoch: b.epoch, Seq: b.seq},
+		Replay:   replay,
+		Events:   sub.events,
+		Cancel: func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.remove(sub)
+		},
+	}, nil
+}
+
+// Publish never waits for subscribers. One whose buffer is full is dropped
+// instead, it can resume from the last event it received.
+func (b *Broker) Publish(ctx context.Context, event models.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	published := models.WatchEvent{Event: event, Position: models.FeedPosition{Epoch: b.epoch, Seq: b.seq}}
+	b.history = append(b.history, published)
+	if len(b.history) >= 2*b.retain {
+		b.history = append([]models.WatchEvent(nil), b.history[len(b.history)-b.retain:]...)
+	}
+	for sub := range b.subscribers {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.events <- published:
+		default:
+			b.remove(sub)
+		}
+	}
+	return nil
+}
+
+func (b *Broker) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
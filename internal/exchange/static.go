@@ -0,0 +1,106 @@
+// Package exchange provides exchange rate sources for converting amounts
+// between currencies.
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+const dateFormat = "2006-01-02"
+
+// StaticProvider serves rates from a fixed table, which makes it usable
+// offline. Every snapshot lists the price of one unit of the base currency in
+// other currencies; cross rates are derived through the base. The zero value
+// has no rates at all.
+type StaticProvider struct {
+	base      string
+	snapshots []snapshot
+}
+
+type snapshot struct {
+	date  time.Time
+	rates map[string]*big.Rat
+}
+
+type ratesFile struct {
+	Base  string                       `yaml:"base"`
+	Rates map[string]map[string]string `yaml:"rates"`
+}
+
+// LoadFile reads a YAML or JSON rates file:
+//
+//	base: EUR
+//	rates:
+//	  "2024-01-01":
+//	    USD: "1.1050"
+//	    GBP: "0.8671"
+func LoadFile(path string) (*StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read rates file: %w", err)
+	}
+	var file ratesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse rates file %s: %w", path, err)
+	}
+	return NewStaticProvider(file.Base, file.Rates)
+}
+
+// NewStaticProvider builds a provider from rates keyed by date (YYYY-MM-DD)
+// and currency code, with values given as decimal strings.
+func NewStaticProvider(base string, rates map[string]map[string]string) (*StaticProvider, error) {
+	if !models.IsCurrency(base) {
+		return nil, fmt.Errorf("invalid base currency %q", base)
+	}
+	p := &StaticProvider{base: base}
+	for day, dayRates := range rates {
+		date, err := time.Parse(dateFormat, day)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rates date %q: %w", day, err)
+		}
+		snap := snapshot{date: date, rates: make(map[string]*big.Rat, len(dayRates)+1)}
+		for code, value := range dayRates {
+			if !models.IsCurrency(code) {
+				return nil, fmt.Errorf("invalid currency %q on %s", code, day)
+			}
+			rate, ok := new(big.Rat).SetString(value)
+			if !ok || rate.Sign() <= 0 {
+				return nil, fmt.Errorf("invalid rate %q for %s on %s", value, code, day)
+			}
+			snap.rates[code] = rate
+		}
+		snap.rates[base] = big.NewRat(1, 1)
+		p.snapshots = append(p.snapshots, snap)
+	}
+	sort.Slice(p.snapshots, func(i, j int) bool {
+		return p.snapshots[i].date.Before(p.snapshots[j].date)
+	})
+	return p, nil
+}
+
+// Rate returns the price of one unit of from in to, using the latest
+// snapshot not later than date that knows both currencies, together with the
+// date of that snapshot.
+func (p *StaticProvider) Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, time.Time, error) {
+	for i := len(p.snapshots) - 1; i >= 0; i-- {
+		snap := p.snapshots[i]
+		if snap.date.After(date) {
+			continue
+		}
+		fromRate, okFrom := snap.rates[from]
+		toRate, okTo := snap.rates[to]
+		if okFrom && okTo {
+			return new(big.Rat).Quo(toRate, fromRate), snap.date, nil
+		}
+	}
+	return nil, time.Time{}, apperrors.FailedPrecondition("no exchange rate from %s to %s on %s", from, to, date.Format(dateFormat))
+}
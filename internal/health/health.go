@@ -0,0 +1,95 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Check func(ctx context.Context) error
+
+// Checker periodically runs dependency checks and publishes the result
+// through the standard grpc.health.v1 service.
+type Checker struct {
+	server   *health.Server
+	services []string
+	interval time.Duration
+
+	mu      sync.Mutex
+	names   []string
+	checks  []Check
+	serving bool
+}
+
+// NewChecker reports the overall status ("") plus every service in services.
+// All of them start as NOT_SERVING until the first round of checks passes.
+func NewChecker(server *health.Server, interval time.Duration, services ...string) *Checker {
+	c := &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		interval: interval,
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.names = append(c.names, name)
+	c.checks = append(c.checks, check)
+}
+
+// Run blocks until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.checkOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) checkOnce(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ctx, cancel := context.WithTimeout(ctx, c.interval)
+	defer cancel()
+
+	healthy := true
+	for i, check := range c.checks {
+		if err := check(ctx); err != nil {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return
+			}
+			log.Printf("health check %s failed: %v", c.names[i], err)
+			healthy = false
+		}
+	}
+	if healthy == c.serving {
+		return
+	}
+	c.serving = healthy
+	if healthy {
+		log.Println("health: serving")
+		c.setStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		log.Println("health: not serving")
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// AuditEntry records one change of a budget. Entries are only ever appended.
+type AuditEntry struct {
+	ID       string `bson:"_id,omitempty"`
+	UserID   string `bson:"user_id"`
+	BudgetID string `bson:"budget_id"`
+	// Actor is who made the change, "system" for background jobs.
+	Actor     string        `bson:"actor"`
+	RPC       string        `bson:"rpc"`
+	RequestID string        `bson:"request_id,omitempty"`
+	Time      time.Time     `bson:"time"`
+	Changes   []FieldChange `bson:"changes"`
+}
+
+// FieldChange is a changed field with its formatted values; Before is empty
+// for created fields and After for removed ones. Category fields are named
+// categories[<category ID>].<field> and expense fields
+// expenses[<expense ID>].<field>.
+type FieldChange struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before,omitempty"`
+	After  string `bson:"after,omitempty"`
+}
+
+type GetBudgetHistory struct {
+	UserID    string `validate:"required"`
+	BudgetID  string `validate:"required"`
+	PageSize  int    `validate:"gte=0"`
+	PageToken string
+}
+
+// HistoryQuery selects one page of a budget's audit entries in storage,
+// newest first.
+type HistoryQuery struct {
+	UserID   string
+	BudgetID string
+	// After continues the listing behind this position.
+	After *HistoryCursor
+	Limit int
+}
+
+// HistoryCursor is the position of an entry in a budget's history.
+type HistoryCursor struct {
+	ID   string    `json:"id"`
+	Time time.Time `json:"t"`
+}
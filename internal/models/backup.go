@@ -0,0 +1,83 @@
+package models
+
+import "time"
+
+// BackupKind and BackupVersion identify the backup format. The version is
+// raised whenever a field changes meaning, restores accept every version up
+// to the current one.
+const (
+	BackupKind    = "moneykeeper.budget.backup"
+	BackupVersion = 1
+)
+
+// Ways to restore a backup. A fresh restore keeps all IDs and requires a user
+// without budgets, a merge assigns new IDs and adds to the existing budgets.
+const (
+	RestoreFresh = "fresh"
+	RestoreMerge = "merge"
+)
+
+// Backup is the JSON document of everything a user owns in the budgets
+// collection, including the trash. Amounts are decimal strings such as
+// "12.50" and times are RFC 3339.
+type Backup struct {
+	Kind      string         `json:"kind"`
+	Version   int            `json:"version"`
+	UserID    string         `json:"userId"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Budgets   []BackupBudget `json:"budgets"`
+}
+
+type BackupBudget struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Limit      string            `json:"limit"`
+	Currency   string            `json:"currency,omitempty"`
+	Allocation string            `json:"allocation,omitempty"`
+	StartDate  time.Time         `json:"start"`
+	EndDate    time.Time         `json:"end"`
+	Categories []BackupCategory  `json:"categories"`
+	Recurrence *BackupRecurrence `json:"recurrence,omitempty"`
+	SeriesID   string            `json:"seriesId,omitempty"`
+	NextID     string            `json:"nextId,omitempty"`
+	Members    []BackupMember    `json:"members,omitempty"`
+	Version    int64             `json:"version"`
+	DeletedAt  *time.Time        `json:"deletedAt,omitempty"`
+}
+
+type BackupCategory struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Limit     string          `json:"limit"`
+	Carried   string          `json:"carried,omitempty"`
+	Rollover  *BackupRollover `json:"rollover,omitempty"`
+	DeletedAt *time.Time      `json:"deletedAt,omitempty"`
+}
+
+type BackupRecurrence struct {
+	Period string `json:"period"`
+	Active bool   `json:"active"`
+}
+
+type BackupRollover struct {
+	Mode string `json:"mode"`
+	Cap  string `json:"cap,omitempty"`
+}
+
+type BackupMember struct {
+	UserID  string    `json:"userId"`
+	Role    string    `json:"role"`
+	AddedAt time.Time `json:"addedAt"`
+}
+
+type RestoreUserData struct {
+	UserID string `validate:"required"`
+	Data   []byte `validate:"required"`
+	// Mode defaults to RestoreFresh.
+	Mode string
+}
+
+// RestoreResult maps the budget IDs of the backup to the restored ones.
+type RestoreResult struct {
+	BudgetIDs map[string]string
+}
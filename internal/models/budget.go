@@ -3,51 +3,199 @@ package models
 import "time"
 
 type Budget struct {
-	ID        string     `bson:"_id,omitempty"`
-	UserID    string     `bson:"user_id"`
-	Name      string     `bson:"name"` 
-	Limit     float64    `bson:"limit"`
-	StartDate time.Time  `bson:"start"`
-	EndDate   time.Time  `bson:"end"`
-	Category  []Category `bson:"categories"`
+	ID         string      `bson:"_id,omitempty"`
+	UserID     string      `bson:"user_id"`
+	Name       string      `bson:"name"`
+	Limit      Money       `bson:"limit"`
+	Currency   string      `bson:"currency,omitempty"`
+	StartDate  time.Time   `bson:"start"`
+	EndDate    time.Time   `bson:"end"`
+	Category   []Category  `bson:"categories"`
+	Recurrence *Recurrence `bson:"recurrence,omitempty"`
+	// SeriesID links all instances of a recurring budget.
+	SeriesID string `bson:"series_id,omitempty"`
+	// NextID is set once the budget has been renewed.
+	NextID string `bson:"next_id,omitempty"`
+	// Allocation is the policy for category limits exceeding Limit, empty
+	// means unrestricted.
+	Allocation string `bson:"allocation,omitempty"`
+	// Members are the users the owner shared the budget with.
+	Members []Member `bson:"members,omitempty"`
+	// Version is incremented by every change and guards against lost updates.
+	Version int64 `bson:"version"`
+	// DeletedAt marks a budget in the trash, it is purged after a retention
+	// period.
+	DeletedAt *time.Time `bson:"deleted_at,omitempty"`
+}
+
+// Roles of the users with access to a budget, each allowing what the ones
+// after it do. The owner is the budget's UserID and is not listed among the
+// members.
+const (
+	RoleOwner  = "owner"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+var roleRanks = map[string]int{RoleViewer: 1, RoleEditor: 2, RoleOwner: 3}
+
+// RoleAllows reports whether role grants at least the access of required.
+func RoleAllows(role, required string) bool {
+	return role != "" && roleRanks[role] >= roleRanks[required]
+}
+
+type Member struct {
+	UserID  string    `bson:"user_id"`
+	Role    string    `bson:"role"`
+	AddedAt time.Time `bson:"added_at"`
+}
+
+// RoleOf returns the role of the user on the budget, empty if the user has
+// no access.
+func (b Budget) RoleOf(userID string) string {
+	if b.UserID == userID {
+		return RoleOwner
+	}
+	for _, member := range b.Members {
+		if member.UserID == userID {
+			return member.Role
+		}
+	}
+	return ""
+}
+
+type Recurrence struct {
+	Period string `bson:"period"`
+	Active bool   `bson:"active"`
 }
 
 type Category struct {
-	ID    string  `bson:"category_id,omitempty"`
-	Name  string  `bson:"name"`
-	Limit float64 `bson:"limit"`
+	ID    string `bson:"category_id,omitempty"`
+	Name  string `bson:"name"`
+	Limit Money  `bson:"limit"`
+	// Carried is the amount rolled over from the previous period, negative
+	// when a debt was carried.
+	Carried   Money      `bson:"carried,omitempty"`
+	Rollover  *Rollover  `bson:"rollover,omitempty"`
+	DeletedAt *time.Time `bson:"deleted_at,omitempty"`
+}
+
+// DeletedCategory is a category in the trash of a budget that is itself not
+// deleted.
+type DeletedCategory struct {
+	BudgetID string
+	Category Category
 }
+
+func (c Category) EffectiveLimit() Money {
+	return c.Limit + c.Carried
+}
+
+const (
+	RolloverNone    = "none"
+	RolloverSurplus = "surplus"
+	RolloverDebt    = "debt"
+	RolloverBoth    = "both"
+)
+
+// Allocation policies decide what happens when the category limits of a
+// budget add up to more than its limit.
+const (
+	AllocationUnrestricted = "unrestricted"
+	AllocationWarn         = "warn"
+	AllocationStrict       = "strict"
+)
+
+type Rollover struct {
+	Mode string `bson:"mode"`
+	// Cap limits the carried amount in both directions, zero means no cap.
+	Cap Money `bson:"cap,omitempty"`
+}
+type BudgetSummary struct {
+	BudgetID      string
+	Name          string
+	Limit         Money
+	Spent         Money
+	Remaining     Money
+	PercentUsed   float64
+	DaysElapsed   int
+	DaysRemaining int
+	Unallocated   Money
+	Categories    []CategorySummary
+}
+
+type CategorySummary struct {
+	CategoryID  string
+	Name        string
+	Limit       Money
+	Spent       Money
+	Remaining   Money
+	PercentUsed float64
+}
+
 type CreateBudget struct {
+	UserID     string `validate:"required"`
+	Name       string `validate:"required"`
+	Limit      Money  `validate:"required"`
+	Currency   string
+	Allocation string
+	Period     string
+	StartDate  string
+	EndDate    string
+	Recurring  bool
+}
+
+// CloneBudget copies a budget into the period that follows it, or between
+// StartDate and EndDate when both are set.
+type CloneBudget struct {
 	UserID    string `validate:"required"`
-	Name      string `validate:"required"`
-	Limit     float64 `validate:"required"`
-	Period    string 
+	BudgetID  string `validate:"required"`
 	StartDate string
 	EndDate   string
+	// Scale is the percentage of the original limits, zero keeps them.
+	Scale Percent
 }
 
 type CreateCategory struct {
 	BudgetID string `validate:"required"`
-	UserID    string `validate:"required"`
-	Name      string `validate:"required"`
-	Limit     float64 `validate:"required"`
+	UserID   string `validate:"required"`
+	Name     string `validate:"required"`
+	Limit    Money  `validate:"required"`
+	Rollover *Rollover
+	// Version, if set, must match the current budget version.
+	Version *int64
 }
 
 type GetUpdateBudget struct{
-	BudgetID string `validate:"required"`
-	UserID string `validate:"required"`
-	Name *string 
-	Limit *float64 
-	Start *string
-	End *string
+	BudgetID   string `validate:"required"`
+	UserID     string `validate:"required"`
+	Name       *string
+	Limit      *Money
+	Start      *string
+	End        *string
+	Allocation *string
+	// Version, if set, must match the current budget version.
+	Version *int64
 }
 
 
 type GetUpdateCategory struct{
-	BudgetID string `validate:"required"`
-	CategoryID string `validate:"required"`
-	UserID string `validate:"required"`
-	Name *string
-	Limit *float64
+	BudgetID     string `validate:"required"`
+	CategoryID   string `validate:"required"`
+	UserID       string `validate:"required"`
+	Name         *string
+	Limit        *Money
+	RolloverMode *string
+	RolloverCap  *Money
+	// Version, if set, must match the current budget version.
+	Version *int64
 }
 
+type ShareBudget struct {
+	UserID   string `validate:"required"`
+	BudgetID string `validate:"required"`
+	MemberID string `validate:"required"`
+	Role     string `validate:"required"`
+	// Version, if set, must match the current budget version.
+	Version *int64
+}
@@ -0,0 +1,34 @@
+package models
+
+// Kinds of problems an import reports.
+const (
+	ImportInvalidRow        = "invalid_row"
+	ImportDuplicateCategory = "duplicate_category"
+	ImportOverlap           = "overlap"
+)
+
+// ImportProblem is a reason a row of an import can not be committed. Row is
+// the line of the row in the CSV.
+type ImportProblem struct {
+	Row     int
+	Kind    string
+	Message string
+}
+
+// ImportResult counts the budgets and categories of an import. Nothing is
+// committed on a dry run or when there are problems.
+type ImportResult struct {
+	Budgets    int
+	Categories int
+	Problems   []ImportProblem
+	Committed  bool
+}
+
+type ExportBudgets struct {
+	UserID string `validate:"required"`
+}
+
+type ImportBudgets struct {
+	UserID string `validate:"required"`
+	DryRun bool
+}
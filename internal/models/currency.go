@@ -0,0 +1,69 @@
+package models
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// currencies holds the active ISO 4217 currency codes.
+var currencies = toSet(`
+AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB
+BOV BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUP
+CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ
+GYD HKD HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW
+KRW KWD KYD KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR
+MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN
+PYG QAR RON RSD RUB RWF SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC
+SYP SZL THB TJS TMT TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS
+VED VES VND VUV WST XAF XAG XAU XBA XBB XBC XBD XCD XDR XOF XPD XPF XPT XSU
+XUA YER ZAR ZMW ZWG
+`)
+
+func toSet(list string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, code := range strings.Fields(list) {
+		set[code] = struct{}{}
+	}
+	return set
+}
+
+// withoutCents holds the codes whose minor unit is not a hundredth: zero,
+// three and four decimal currencies and the precious metals and bond units
+// that have no minor unit at all.
+var withoutCents = toSet(`
+BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX UYI VND VUV XAF XOF XPF
+BHD IQD JOD KWD LYD OMR TND
+CLF UYW
+XAG XAU XBA XBB XBC XBD XDR XPD XPT XSU XUA
+`)
+
+func IsCurrency(code string) bool {
+	_, ok := currencies[code]
+	return ok
+}
+
+// HasCents reports whether amounts in code are counted in hundredths, the
+// only scale Money can hold.
+func HasCents(code string) bool {
+	_, ok := withoutCents[code]
+	return IsCurrency(code) && !ok
+}
+
+// Convert multiplies m by rate, rounding half away from zero to whole cents.
+func (m Money) Convert(rate *big.Rat) (Money, error) {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(int64(m)), rate)
+	quo, rem := new(big.Int).QuoRem(product.Num(), product.Denom(), new(big.Int))
+	// |rem| * 2 >= denom means the fraction is at least one half
+	if rem.Abs(rem).Lsh(rem, 1).Cmp(product.Denom()) >= 0 {
+		if product.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	if !quo.IsInt64() || quo.Int64() > maxUnits*CentsPerUnit || quo.Int64() < -maxUnits*CentsPerUnit {
+		return 0, errors.New("amount is out of range")
+	}
+	return Money(quo.Int64()), nil
+}
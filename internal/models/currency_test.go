@@ -0,0 +1,60 @@
+package models
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Money
+		rate   *big.Rat
+		want   Money
+		err    bool
+	}{
+		{"exact", 1000, big.NewRat(3, 2), 1500, false},
+		{"half rounds up", 1, big.NewRat(1, 2), 1, false},
+		{"below half rounds down", 1, big.NewRat(49, 100), 0, false},
+		{"negative half rounds away from zero", -1, big.NewRat(1, 2), -1, false},
+		{"negative below half", -1, big.NewRat(49, 100), 0, false},
+		{"third", 100, big.NewRat(1, 3), 33, false},
+		{"zero rate", 12345, new(big.Rat), 0, false},
+		{"largest amount", maxUnits * CentsPerUnit, big.NewRat(1, 1), maxUnits * CentsPerUnit, false},
+		{"beyond max units", maxUnits * CentsPerUnit, big.NewRat(101, 100), 0, true},
+		{"beyond int64", math.MaxInt64 / 2, big.NewRat(3, 1), 0, true},
+		{"beyond int64 negative", math.MinInt64 / 2, big.NewRat(3, 1), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.amount.Convert(tt.rate)
+			if (err != nil) != tt.err {
+				t.Fatalf("Convert(%d, %s) error = %v, want error %v", tt.amount, tt.rate, err, tt.err)
+			}
+			if got != tt.want {
+				t.Fatalf("Convert(%d, %s) = %d, want %d", tt.amount, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasCents(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"USD", true},
+		{"EUR", true},
+		{"JPY", false},
+		{"KWD", false},
+		{"CLF", false},
+		{"XAU", false},
+		{"ABC", false},
+	}
+	for _, tt := range tests {
+		if got := HasCents(tt.code); got != tt.want {
+			t.Errorf("HasCents(%s) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
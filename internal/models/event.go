@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+// Event types published for changes of budgets and their categories.
+const (
+	EventBudgetCreated     = "BudgetCreated"
+	EventBudgetRenewed     = "BudgetRenewed"
+	EventBudgetUpdated     = "BudgetUpdated"
+	EventLimitChanged      = "LimitChanged"
+	EventRecurrenceStopped = "RecurrenceStopped"
+	EventBudgetDeleted     = "BudgetDeleted"
+	EventBudgetRestored    = "BudgetRestored"
+	EventBudgetPurged      = "BudgetPurged"
+	EventCategoryAdded     = "CategoryAdded"
+	EventCategoryUpdated   = "CategoryUpdated"
+	EventCategoryDeleted   = "CategoryDeleted"
+	EventCategoryRestored  = "CategoryRestored"
+	EventBudgetShared      = "BudgetShared"
+	EventBudgetUnshared    = "BudgetUnshared"
+	EventThresholdCrossed  = "ThresholdCrossed"
+	// EventWatchStarted opens every watch. It only carries the position to
+	// resume from.
+	EventWatchStarted = "WatchStarted"
+)
+
+// Event is a domain event. It is written to the outbox together with the
+// change it describes and published from there at least once, so consumers
+// have to deduplicate by ID.
+type Event struct {
+	ID       string `bson:"_id,omitempty"`
+	Type     string `bson:"type"`
+	UserID   string `bson:"user_id"`
+	BudgetID string `bson:"budget_id"`
+	// Members are the users the budget is shared with, before or after the
+	// change, so they see it too.
+	Members []string `bson:"members,omitempty"`
+	// CategoryID is set for category events and limit changes of a category.
+	CategoryID string        `bson:"category_id,omitempty"`
+	OccurredAt time.Time     `bson:"occurred_at"`
+	Changes    []FieldChange `bson:"changes"`
+	// Threshold is the percentage of the limit a ThresholdCrossed event is
+	// about.
+	Threshold int `bson:"threshold,omitempty"`
+	// Delivery state kept by the outbox.
+	Attempts    int       `bson:"attempts"`
+	NextAttempt time.Time `bson:"next_attempt"`
+	LastError   string    `bson:"last_error,omitempty"`
+	DeadLetter  bool      `bson:"dead_letter,omitempty"`
+}
+
+// FeedPosition identifies an event published to the watch feed. Positions
+// only compare within one epoch, which starts anew with every process.
+type FeedPosition struct {
+	Epoch string `json:"e"`
+	Seq   uint64 `json:"s"`
+}
+
+type WatchEvent struct {
+	Event    Event
+	Position FeedPosition
+}
+
+// Subscription is a watcher's view of the feed.
+type Subscription struct {
+	// Position is the last event published before subscribing.
+	Position FeedPosition
+	// Replay holds the retained events published after the position the
+	// watcher resumed from.
+	Replay []WatchEvent
+	// Events delivers the events published from now on. It is closed when
+	// the subscription is cancelled or could not keep up.
+	Events <-chan WatchEvent
+	Cancel func()
+}
+
+type WatchBudgets struct {
+	UserID      string `validate:"required"`
+	ResumeToken string
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+type Expense struct {
+	ID string `bson:"_id,omitempty"`
+	// UserID is the owner of the budget, CreatedBy the member who recorded
+	// the expense if that was someone else.
+	UserID     string    `bson:"user_id"`
+	CreatedBy  string    `bson:"created_by,omitempty"`
+	BudgetID   string    `bson:"budget_id"`
+	CategoryID string    `bson:"category_id"`
+	Amount     Money     `bson:"amount"`
+	Currency   string    `bson:"currency,omitempty"`
+	Date       time.Time `bson:"date"`
+	Note       string    `bson:"note"`
+	// Original is set when the expense was recorded in a currency other than
+	// the budget's one; Amount then holds the converted value.
+	Original *ForeignAmount `bson:"original,omitempty"`
+}
+
+type ForeignAmount struct {
+	Amount   Money  `bson:"amount"`
+	Currency string `bson:"currency"`
+	// Rate is the price of one unit of Currency in the budget currency.
+	Rate     string    `bson:"rate"`
+	RateDate time.Time `bson:"rate_date"`
+}
+
+type CreateExpense struct {
+	UserID     string `validate:"required"`
+	BudgetID   string `validate:"required"`
+	CategoryID string `validate:"required"`
+	Amount     Money  `validate:"required"`
+	Currency   string
+	Date       string
+	Note       string
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+const (
+	BudgetOrderStart = "start"
+	BudgetOrderName  = "name"
+	BudgetOrderLimit = "limit"
+)
+
+// ListBudgets is a GetBudgetList request. Dates use the YYYY-MM-DD format and
+// empty fields disable the corresponding filter.
+type ListBudgets struct {
+	UserID     string `validate:"required"`
+	ActiveAt   string
+	From       string
+	To         string
+	NamePrefix string
+	Category   string
+	OrderBy    string
+	Descending bool
+	PageSize   int `validate:"gte=0"`
+	PageToken  string
+}
+
+// BudgetQuery selects one page of a user's budgets in storage.
+type BudgetQuery struct {
+	UserID string
+	// StartsBefore keeps budgets with start < StartsBefore.
+	StartsBefore *time.Time
+	// EndsNotBefore keeps budgets with end >= EndsNotBefore.
+	EndsNotBefore *time.Time
+	NamePrefix    string
+	// Category keeps budgets having a category with this name.
+	Category   string
+	OrderBy    string
+	Descending bool
+	// After continues the listing behind this position.
+	After *BudgetCursor
+	// Limit caps the number of budgets, 0 returns all of them.
+	Limit int
+}
+
+// BudgetCursor is the position of a budget in a listing: the value of the
+// ordering field with the ID as a tie-breaker.
+type BudgetCursor struct {
+	ID    string    `json:"id"`
+	Start time.Time `json:"start"`
+	Name  string    `json:"name,omitempty"`
+	Limit Money     `json:"limit,omitempty"`
+}
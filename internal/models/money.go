@@ -0,0 +1,101 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount of money in cents, i.e. hundredths of the
+// currency unit. It is stored in MongoDB as a 64-bit integer.
+type Money int64
+
+const (
+	CentsPerUnit = 100
+	nanosPerUnit = 1_000_000_000
+	nanosPerCent = nanosPerUnit / CentsPerUnit
+	maxUnits     = math.MaxInt64/CentsPerUnit - 1
+)
+
+// MoneyFromUnits converts the units+nanos representation used by the API.
+// Both parts must have the same sign and nanos must be a whole number of cents.
+func MoneyFromUnits(units int64, nanos int32) (Money, error) {
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit {
+		return 0, fmt.Errorf("nanos must be within (-1e9, 1e9), got %d", nanos)
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return 0, fmt.Errorf("units and nanos must have the same sign")
+	}
+	if units > maxUnits || units < -maxUnits {
+		return 0, fmt.Errorf("amount is out of range")
+	}
+	if nanos%nanosPerCent != 0 {
+		return 0, fmt.Errorf("amounts support at most two decimal places")
+	}
+	return Money(units*CentsPerUnit + int64(nanos/nanosPerCent)), nil
+}
+
+// ParseMoney parses a decimal string such as "1234.56" or "-3.5".
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	digits, negative := strings.CutPrefix(value, "-")
+	whole, frac, _ := strings.Cut(digits, ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	if !isDigits(whole) || !isDigits(frac) || len(frac) > 2 {
+		return 0, fmt.Errorf("invalid amount %q: expected a number with at most two decimal places", value)
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > maxUnits {
+		return 0, fmt.Errorf("invalid amount %q: out of range", value)
+	}
+	cents, _ := strconv.ParseInt(frac+strings.Repeat("0", 2-len(frac)), 10, 64)
+	m := Money(units*CentsPerUnit + cents)
+	if negative {
+		m = -m
+	}
+	return m, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (m Money) Units() (int64, int32) {
+	return int64(m) / CentsPerUnit, int32(int64(m)%CentsPerUnit) * nanosPerCent
+}
+
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+func (m Money) String() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+	}
+	abs := m.Abs()
+	return fmt.Sprintf("%s%d.%02d", sign, abs/CentsPerUnit, abs%CentsPerUnit)
+}
+
+// PercentOf returns m as a percentage of total, or 0 when total is zero.
+// The result is meant for display only.
+func (m Money) PercentOf(total Money) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(m) / float64(total) * 100
+}
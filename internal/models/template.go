@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Template is a user's blueprint for new budgets. It carries no dates; the
+// period is chosen when a budget is created from it.
+type Template struct {
+	ID         string             `bson:"_id,omitempty"`
+	UserID     string             `bson:"user_id"`
+	Name       string             `bson:"name"`
+	Limit      Money              `bson:"limit"`
+	Currency   string             `bson:"currency,omitempty"`
+	Allocation string             `bson:"allocation,omitempty"`
+	Categories []TemplateCategory `bson:"categories"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+}
+
+// TemplateCategory has either a fixed Limit or a Percent of the limit of the
+// budget created from the template.
+type TemplateCategory struct {
+	Name     string    `bson:"name"`
+	Limit    Money     `bson:"limit,omitempty"`
+	Percent  Percent   `bson:"percent,omitempty"`
+	Rollover *Rollover `bson:"rollover,omitempty"`
+}
+
+// LimitOf returns the category limit in a budget with the given limit.
+func (c TemplateCategory) LimitOf(budgetLimit Money) (Money, error) {
+	if c.Percent != 0 {
+		return c.Percent.Of(budgetLimit)
+	}
+	return c.Limit, nil
+}
+
+// Percent is a percentage in hundredths of a percent, so 12.5% is 1250.
+type Percent int64
+
+const FullPercent Percent = 100 * 100
+
+// ParsePercent parses a decimal string such as "12.5".
+func ParsePercent(value string) (Percent, error) {
+	amount, err := ParseMoney(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid percentage %q: expected a number with at most two decimal places", value)
+	}
+	return Percent(amount), nil
+}
+
+func (p Percent) String() string {
+	return Money(p).String()
+}
+
+// Of returns the percentage of amount, rounded half away from zero to whole
+// cents.
+func (p Percent) Of(amount Money) (Money, error) {
+	return amount.Convert(big.NewRat(int64(p), int64(FullPercent)))
+}
+
+type CreateTemplate struct {
+	UserID     string `validate:"required"`
+	Name       string `validate:"required"`
+	Limit      Money  `validate:"required"`
+	Currency   string
+	Allocation string
+	Categories []TemplateCategory
+}
+
+// UpdateTemplate replaces all fields of a template.
+type UpdateTemplate struct {
+	TemplateID string `validate:"required"`
+	CreateTemplate
+}
+
+type SaveBudgetAsTemplate struct {
+	UserID   string `validate:"required"`
+	BudgetID string `validate:"required"`
+	// Name defaults to the name of the budget.
+	Name string
+}
+
+// AddBudgetFromTemplate creates a budget for either a period starting now or
+// the given dates.
+type AddBudgetFromTemplate struct {
+	UserID     string `validate:"required"`
+	TemplateID string `validate:"required"`
+	// Name defaults to the name of the template.
+	Name      string
+	Period    string
+	StartDate string
+	EndDate   string
+	Recurring bool
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AuditRepo struct {
+	collection *mongo.Collection
+}
+
+func NewAuditRepository(db *mongo.Database, collection string) *AuditRepo {
+	return &AuditRepo{
+		collection: db.Collection(collection),
+	}
+}
+
+func (r *AuditRepo) AddAuditEntry(ctx context.Context, entry models.AuditEntry) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	return err
+}
+
+func (r *AuditRepo) GetBudgetHistory(ctx context.Context, query models.HistoryQuery) ([]models.AuditEntry, error) {
+	filter := bson.M{"user_id": query.UserID, "budget_id": query.BudgetID}
+	if query.After != nil {
+		oid, err := convertToObjectIDs(query.After.ID)
+		if err != nil {
+			return nil, err
+		}
+		filter["$or"] = bson.A{
+			bson.M{"time": bson.M{"$lt": query.After.Time}},
+			bson.M{"time": query.After.Time, "_id": bson.M{"$lt": oid[0]}},
+		}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "time", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(query.Limit))
+
+	entries := []models.AuditEntry{}
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &entries)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
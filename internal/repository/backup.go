@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *BudgetRepo) GetUserBudgets(ctx context.Context, userID string) ([]models.Budget, error) {
+	budgets := []models.Budget{}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &budgets)
+	if err != nil {
+		return nil, err
+	}
+	return budgets, nil
+}
+
+func (r *BudgetRepo) InsertBudgets(ctx context.Context, budgets []models.Budget) error {
+	if len(budgets) == 0 {
+		return nil
+	}
+	docs := make([]any, len(budgets))
+	for i, budget := range budgets {
+		oid, err := convertToObjectIDs(budget.ID)
+		if err != nil {
+			return err
+		}
+		doc, err := toDocument(budget)
+		if err != nil {
+			return err
+		}
+		doc["_id"] = oid[0]
+		docs[i] = doc
+	}
+	if _, err := r.collection.InsertMany(ctx, docs); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return apperrors.AlreadyExists("a budget of the backup already exists")
+		}
+		return err
+	}
+	return nil
+}
@@ -2,27 +2,31 @@ package repository
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
 	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type BudgetRepo struct {
 	collection *mongo.Collection
 }
 
-func NewBudgetRepository(db *mongo.Client) *BudgetRepo {
+func NewBudgetRepository(db *mongo.Database, collection string) *BudgetRepo {
 	return &BudgetRepo{
-		collection: db.Database(dbname).Collection(budgetCollection),
+		collection: db.Collection(collection),
 	}
 }
 
 func (r *BudgetRepo) AddBudget(ctx context.Context, budget models.Budget) (string, error) {
+	budget.Category = withCategoryIDs(budget.Category)
+	budget.Version = 1
 	result, err := r.collection.InsertOne(ctx, budget)
 	if err != nil {
 		return "", err
@@ -33,22 +37,30 @@ func (r *BudgetRepo) AddBudget(ctx context.Context, budget models.Budget) (strin
 func (r *BudgetRepo) GetBudget(ctx context.Context, userID, budgetID string) (*models.Budget, error) {
 	oid, err := convertToObjectIDs(budgetID)
 	if err != nil {
-		return nil, fmt.Errorf("InvalidID: %v", err)
+		return nil, err
 	}
 	var budget models.Budget
-	err = r.collection.FindOne(ctx, bson.M{"_id": oid[0], "user_id": userID}).Decode(&budget)
+	filter := accessFilter(userID)
+	filter["_id"], filter["deleted_at"] = oid[0], nil
+	err = r.collection.FindOne(ctx, filter).Decode(&budget)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
 		}
 		return nil, err
 	}
+	budget.Category = activeCategories(budget.Category)
 	return &budget, err
 }
 
+// accessFilter matches the budgets the user owns or is a member of.
+func accessFilter(userID string) bson.M {
+	return bson.M{"$or": bson.A{bson.M{"user_id": userID}, bson.M{"members.user_id": userID}}}
+}
+
 func (r *BudgetRepo) GetBudgetList(ctx context.Context, userID string) ([]models.Budget, error) {
 	budgets := []models.Budget{}
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID, "deleted_at": nil})
 	if err != nil {
 		return nil, err
 	}
@@ -56,61 +68,156 @@ func (r *BudgetRepo) GetBudgetList(ctx context.Context, userID string) ([]models
 	if err != nil {
 		return nil, err
 	}
-	return budgets, err
+	return withActiveCategories(budgets), err
 }
 
-func (r *BudgetRepo) AddCategory(ctx context.Context, categ models.CreateCategory) error {
+func (r *BudgetRepo) ListBudgets(ctx context.Context, query models.BudgetQuery) ([]models.Budget, error) {
+	filter := accessFilter(query.UserID)
+	filter["deleted_at"] = nil
+	if query.StartsBefore != nil {
+		filter["start"] = bson.M{"$lt": *query.StartsBefore}
+	}
+	if query.EndsNotBefore != nil {
+		filter["end"] = bson.M{"$gte": *query.EndsNotBefore}
+	}
+	if query.NamePrefix != "" {
+		filter["name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(query.NamePrefix)}
+	}
+	if query.Category != "" {
+		filter["categories"] = bson.M{"$elemMatch": bson.M{"name": query.Category, "deleted_at": nil}}
+	}
+
+	field, direction, op := query.OrderBy, 1, "$gt"
+	if query.Descending {
+		direction, op = -1, "$lt"
+	}
+	if query.After != nil {
+		oid, err := convertToObjectIDs(query.After.ID)
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		switch field {
+		case models.BudgetOrderName:
+			value = query.After.Name
+		case models.BudgetOrderLimit:
+			value = query.After.Limit
+		default:
+			value = query.After.Start
+		}
+		filter["$and"] = bson.A{bson.M{"$or": bson.A{
+			bson.M{field: bson.M{op: value}},
+			bson.M{field: value, "_id": bson.M{op: oid[0]}},
+		}}}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: field, Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(query.Limit))
+
+	budgets := []models.Budget{}
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &budgets)
+	if err != nil {
+		return nil, err
+	}
+	return withActiveCategories(budgets), nil
+}
+
+func (r *BudgetRepo) AddCategory(ctx context.Context, categ models.CreateCategory, version int64) error {
 	oid, err := convertToObjectIDs(categ.BudgetID)
 	if err != nil {
-		return fmt.Errorf("InvalidID: %v", err)
+		return err
 	}
 	categoryID := primitive.NewObjectID()
 
-	filter := bson.M{"_id": oid[0], "user_id": categ.UserID}
-	update := bson.M{"$push": bson.M{"categories": models.Category{
-		Name:  categ.Name,
-		Limit: categ.Limit,
-		ID:    categoryID.Hex(),
-	}}}
+	// the name condition keeps category names unique even without a version
+	// check, deleted categories do not take up their name
+	filter := bson.M{
+		"_id":        oid[0],
+		"user_id":    categ.UserID,
+		"version":    versionFilter(version),
+		"deleted_at": nil,
+		"categories": bson.M{"$not": bson.M{"$elemMatch": bson.M{"name": categ.Name, "deleted_at": nil}}},
+	}
+	update := bson.M{
+		"$push": bson.M{"categories": models.Category{
+			Name:     categ.Name,
+			Limit:    categ.Limit,
+			ID:       categoryID.Hex(),
+			Rollover: categ.Rollover,
+		}},
+		"$inc": bson.M{"version": 1},
+	}
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
 	if result.MatchedCount == 0 {
-		return errors.New("category not found")
+		exists, err := r.collection.CountDocuments(ctx, bson.M{
+			"_id":        oid[0],
+			"user_id":    categ.UserID,
+			"deleted_at": nil,
+			"categories": bson.M{"$elemMatch": bson.M{"name": categ.Name, "deleted_at": nil}},
+		})
+		if err != nil {
+			return err
+		}
+		if exists > 0 {
+			return apperrors.AlreadyExists("category with name %s is already added to this budget", categ.Name)
+		}
+		return r.checkVersion(ctx, oid[0], categ.UserID, version)
 	}
 	return nil
 }
 
-func (r *BudgetRepo) DeleteCategory(ctx context.Context, userID, budgetID, catID string) error {
+func (r *BudgetRepo) DeleteCategory(ctx context.Context, userID, budgetID, catID string, version int64) error {
 	oid, err := convertToObjectIDs(budgetID)
 	if err != nil {
-		return fmt.Errorf("InvalidID: %v", err)
+		return err
+	}
+	filter := bson.M{
+		"_id":        oid[0],
+		"user_id":    userID,
+		"version":    versionFilter(version),
+		"deleted_at": nil,
+		"categories": bson.M{"$elemMatch": bson.M{"category_id": catID, "deleted_at": nil}},
+	}
+	update := bson.M{
+		"$set": bson.M{"categories.$.deleted_at": time.Now().UTC()},
+		"$inc": bson.M{"version": 1},
 	}
-	filter := bson.M{"_id": oid[0], "user_id": userID}
-	update := bson.M{"$pull": bson.M{"categories": bson.M{"category_id": catID}}}
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
-	if result.ModifiedCount == 0 {
-		return errors.New("category not found")
+	if result.MatchedCount == 0 {
+		if err := r.checkVersion(ctx, oid[0], userID, version); err != nil {
+			return err
+		}
+		return apperrors.NotFound("category is not found")
 	}
 	return nil
 }
 
-func (r *BudgetRepo) DeleteBudget(ctx context.Context, userID, budgetID string) error {
+func (r *BudgetRepo) DeleteBudget(ctx context.Context, userID, budgetID string, version int64) error {
 	oid, err := convertToObjectIDs(budgetID)
 	if err != nil {
-		return fmt.Errorf("InvalidID: %v", err)
+		return err
+	}
+	filter := bson.M{"_id": oid[0], "user_id": userID, "version": versionFilter(version), "deleted_at": nil}
+	update := bson.M{
+		"$set": bson.M{"deleted_at": time.Now().UTC()},
+		"$inc": bson.M{"version": 1},
 	}
-	filter := bson.M{"_id": oid[0], "user_id": userID}
-	result, err := r.collection.DeleteOne(ctx, filter)
+	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
-	if result.DeletedCount == 0 {
-		return errors.New("budget not found")
+	if result.MatchedCount == 0 {
+		return r.checkVersion(ctx, oid[0], userID, version)
 	}
 	return nil
 }
@@ -118,47 +225,138 @@ func (r *BudgetRepo) DeleteBudget(ctx context.Context, userID, budgetID string)
 func (r *BudgetRepo) UpdateBudget(ctx context.Context, updates models.Budget) error {
 	oid, err := convertToObjectIDs(updates.ID)
 	if err != nil {
-		return fmt.Errorf("InvalidID: %v", err)
+		return err
 	}
-	filter := bson.M{"_id": oid[0], "user_id": updates.UserID}
+	filter := bson.M{"_id": oid[0], "user_id": updates.UserID, "version": versionFilter(updates.Version), "deleted_at": nil}
 	update := bson.M{
 		"$set": bson.M{
-			"name":  updates.Name,
-			"limit": updates.Limit,
-			"start": updates.StartDate,
-			"end":   updates.EndDate,
+			"name":       updates.Name,
+			"limit":      updates.Limit,
+			"start":      updates.StartDate,
+			"end":        updates.EndDate,
+			"allocation": updates.Allocation,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
-	if result.ModifiedCount == 0 {
-		return errors.New("UpdateBudget not found")
+	if result.MatchedCount == 0 {
+		return r.checkVersion(ctx, oid[0], updates.UserID, updates.Version)
 	}
 	return nil
 }
 
-func (r *BudgetRepo) UpdateCategory(ctx context.Context, userID, budgetID string, updates models.Category) error {
+func (r *BudgetRepo) UpdateCategory(ctx context.Context, userID, budgetID string, updates models.Category, version int64) error {
 	oid, err := convertToObjectIDs(budgetID)
 	if err != nil {
-		return fmt.Errorf("InvalidID: %v", err)
+		return err
+	}
+	filter := bson.M{
+		"_id":        oid[0],
+		"user_id":    userID,
+		"version":    versionFilter(version),
+		"deleted_at": nil,
+		"categories": bson.M{"$elemMatch": bson.M{"category_id": updates.ID, "deleted_at": nil}},
 	}
-	filter := bson.M{"_id": oid[0], "user_id": userID, "categories.category_id": updates.ID}
 	update := bson.M{
 		"$set": bson.M{
-			"categories.$.name": updates.Name,
-			"categories.$.limit":  updates.Limit,
+			"categories.$.name":     updates.Name,
+			"categories.$.limit":    updates.Limit,
+			"categories.$.carried":  updates.Carried,
+			"categories.$.rollover": updates.Rollover,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
-	if result.ModifiedCount == 0 {
-		return errors.New("UpdateCategory error")
+	if result.MatchedCount == 0 {
+		if err := r.checkVersion(ctx, oid[0], userID, version); err != nil {
+			return err
+		}
+		return apperrors.NotFound("category is not found")
+	}
+	return nil
+}
+
+// UpdateMembers replaces the members of a budget owned by userID.
+func (r *BudgetRepo) UpdateMembers(ctx context.Context, userID, budgetID string, members []models.Member, version int64) error {
+	oid, err := convertToObjectIDs(budgetID)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"_id": oid[0], "user_id": userID, "version": versionFilter(version), "deleted_at": nil}
+	update := bson.M{
+		"$set": bson.M{"members": members},
+		"$inc": bson.M{"version": 1},
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.checkVersion(ctx, oid[0], userID, version)
+	}
+	return nil
+}
+
+// versionFilter matches the given version. Budgets written before versions
+// were introduced have no version field and count as version 0.
+func versionFilter(version int64) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// checkVersion explains why a conditional write matched nothing: the budget
+// is gone or deleted, or it was changed since it was read at version.
+func (r *BudgetRepo) checkVersion(ctx context.Context, oid primitive.ObjectID, userID string, version int64) error {
+	var budget models.Budget
+	err := r.collection.FindOne(ctx, bson.M{"_id": oid, "user_id": userID, "deleted_at": nil}).Decode(&budget)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return apperrors.NotFound("budget is not found")
+		}
+		return err
+	}
+	if budget.Version != version {
+		return apperrors.Conflict("budget was modified concurrently, current version is %d", budget.Version)
 	}
 	return nil
 }
+
+// withCategoryIDs assigns IDs to the categories that do not have one yet.
+func withCategoryIDs(categories []models.Category) []models.Category {
+	withIDs := make([]models.Category, len(categories))
+	for i, categ := range categories {
+		if categ.ID == "" {
+			categ.ID = primitive.NewObjectID().Hex()
+		}
+		withIDs[i] = categ
+	}
+	return withIDs
+}
+
+// activeCategories drops the categories that are in the trash.
+func activeCategories(categories []models.Category) []models.Category {
+	active := make([]models.Category, 0, len(categories))
+	for _, categ := range categories {
+		if categ.DeletedAt == nil {
+			active = append(active, categ)
+		}
+	}
+	return active
+}
+
+func withActiveCategories(budgets []models.Budget) []models.Budget {
+	for i := range budgets {
+		budgets[i].Category = activeCategories(budgets[i].Category)
+	}
+	return budgets
+}
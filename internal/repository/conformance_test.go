@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository/repotest"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The MongoDB repositories are only checked when BUDGET_TEST_MONGO_URI points
+// to a server, preferably with the race detector:
+//
+//	BUDGET_TEST_MONGO_URI=mongodb://localhost:27017 go test -race ./internal/repository/
+//
+// Every run uses a database of its own and drops it afterwards.
+func testDatabase(t *testing.T) *mongo.Database {
+	t.Helper()
+	uri := os.Getenv("BUDGET_TEST_MONGO_URI")
+	if uri == "" {
+		t.Skip("BUDGET_TEST_MONGO_URI is not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	db := client.Database(fmt.Sprintf("mkbudgets_test_%d", time.Now().UnixNano()))
+	if err := EnsureIndexes(ctx, db, "budgets", "audit", "outbox", "templates"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := db.Drop(ctx); err != nil {
+			t.Log(err)
+		}
+		client.Disconnect(ctx)
+	})
+	return db
+}
+
+func TestConformance(t *testing.T) {
+	db := testDatabase(t)
+	newBudgets := func(t *testing.T) service.BudgetRepository { return NewBudgetRepository(db, "budgets") }
+	newLocker := func(t *testing.T) service.Locker { return NewLocker(db, "locks") }
+
+	t.Run("BudgetRepository", func(t *testing.T) {
+		repotest.RunBudgetRepository(t, newBudgets)
+	})
+	t.Run("ExpenseRepository", func(t *testing.T) {
+		repotest.RunExpenseRepository(t, func(t *testing.T) service.ExpenseRepository {
+			return NewExpenseRepository(db, "expenses")
+		})
+	})
+	t.Run("AuditRepository", func(t *testing.T) {
+		repotest.RunAuditRepository(t, func(t *testing.T) service.AuditRepository {
+			return NewAuditRepository(db, "audit")
+		})
+	})
+	t.Run("OutboxRepository", func(t *testing.T) {
+		repotest.RunOutboxRepository(t, func(t *testing.T) service.OutboxRepository {
+			return NewOutboxRepository(db, "outbox")
+		})
+	})
+	t.Run("TemplateRepository", func(t *testing.T) {
+		repotest.RunTemplateRepository(t, func(t *testing.T) service.TemplateRepository {
+			return NewTemplateRepository(db, "templates")
+		})
+	})
+	t.Run("Locker", func(t *testing.T) {
+		repotest.RunLocker(t, newLocker)
+	})
+	t.Run("LockerRenewal", func(t *testing.T) {
+		ctx := context.Background()
+		locker := NewLocker(db, "locks")
+		locker.ttl = 300 * time.Millisecond
+		key := primitive.NewObjectID().Hex()
+		unlock, err := locker.Lock(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// without renewal the lease would have expired three times over
+		time.Sleep(3 * locker.ttl)
+		timeout, cancel := context.WithTimeout(ctx, locker.ttl/2)
+		defer cancel()
+		if _, err := locker.Lock(timeout, key); err == nil {
+			t.Fatal("took a lease that is still held")
+		}
+		unlock()
+		again, err := locker.Lock(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again()
+	})
+	t.Run("ConcurrentWrites", func(t *testing.T) {
+		repotest.RunConcurrentWrites(t, newBudgets, newLocker)
+	})
+	t.Run("Transactor", func(t *testing.T) {
+		repotest.RunTransactor(t, func(t *testing.T) repotest.Storage {
+			return repotest.Storage{
+				Budgets:  NewBudgetRepository(db, "budgets"),
+				Expenses: NewExpenseRepository(db, "expenses"),
+				Audit:    NewAuditRepository(db, "audit"),
+				Outbox:   NewOutboxRepository(db, "outbox"),
+				Tx:       NewTransactor(db.Client()),
+			}
+		})
+	})
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ExpenseRepo struct {
+	collection *mongo.Collection
+}
+
+func NewExpenseRepository(db *mongo.Database, collection string) *ExpenseRepo {
+	return &ExpenseRepo{
+		collection: db.Collection(collection),
+	}
+}
+
+func (r *ExpenseRepo) AddExpense(ctx context.Context, expense models.Expense) (string, error) {
+	result, err := r.collection.InsertOne(ctx, expense)
+	if err != nil {
+		return "", err
+	}
+	return result.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *ExpenseRepo) GetExpense(ctx context.Context, expenseID string) (*models.Expense, error) {
+	oid, err := convertToObjectIDs(expenseID)
+	if err != nil {
+		return nil, err
+	}
+	var expense models.Expense
+	err = r.collection.FindOne(ctx, bson.M{"_id": oid[0]}).Decode(&expense)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &expense, nil
+}
+
+func (r *ExpenseRepo) GetExpenses(ctx context.Context, userID, budgetID, categoryID string) ([]models.Expense, error) {
+	expenses := []models.Expense{}
+	filter := bson.M{"user_id": userID, "budget_id": budgetID}
+	if categoryID != "" {
+		filter["category_id"] = categoryID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &expenses)
+	if err != nil {
+		return nil, err
+	}
+	return expenses, nil
+}
+
+func (r *ExpenseRepo) DeleteExpense(ctx context.Context, userID, expenseID string) error {
+	oid, err := convertToObjectIDs(expenseID)
+	if err != nil {
+		return err
+	}
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": oid[0], "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return apperrors.NotFound("expense is not found")
+	}
+	return nil
+}
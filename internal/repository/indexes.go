@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureIndexes creates the indexes backing budget listings, one per
+// supported order, the one finding shared budgets, the one used by the purge
+// job, the ones backing budget histories and the event relay and the one
+// keeping template names unique. Creating an existing index is a no-op.
+func EnsureIndexes(ctx context.Context, db *mongo.Database, budgetCollection, auditCollection, outboxCollection, templateCollection string) error {
+	budgets := db.Collection(budgetCollection)
+	indexes := []mongo.IndexModel{}
+	for _, field := range []string{"start", "name", "limit"} {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: field, Value: 1}, {Key: "_id", Value: 1}},
+		})
+	}
+	indexes = append(indexes, mongo.IndexModel{
+		Keys:    bson.D{{Key: "members.user_id", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	}, mongo.IndexModel{
+		Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if _, err := budgets.Indexes().CreateMany(ctx, indexes); err != nil {
+		return err
+	}
+	_, err := db.Collection(auditCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "budget_id", Value: 1}, {Key: "time", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection(outboxCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "next_attempt", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection(templateCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// lockTTL bounds how long a crashed holder can block a key.
+	lockTTL           = 30 * time.Second
+	lockRetryInterval = 20 * time.Millisecond
+)
+
+// Locker implements per-key leases in a MongoDB collection, so it serializes
+// callers across service instances. A lease is a document with the key as
+// _id; it is taken by inserting it or by replacing an expired one. The
+// holder renews it every third of the TTL until it unlocks.
+type Locker struct {
+	collection *mongo.Collection
+	ttl        time.Duration
+}
+
+func NewLocker(db *mongo.Database, collection string) *Locker {
+	return &Locker{
+		collection: db.Collection(collection),
+		ttl:        lockTTL,
+	}
+}
+
+func (l *Locker) Lock(ctx context.Context, key string) (func(), error) {
+	owner := primitive.NewObjectID()
+	for {
+		now := time.Now().UTC()
+		filter := bson.M{"_id": key, "expires": bson.M{"$lt": now}}
+		update := bson.M{"$set": bson.M{"owner": owner, "expires": now.Add(l.ttl)}}
+		_, err := l.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if err == nil {
+			break
+		}
+		// the upsert collides with the _id of a lease that is still held
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+
+	renewCtx, stopRenewal := context.WithCancel(context.Background())
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		l.renew(renewCtx, key, owner)
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			stopRenewal()
+			<-renewed
+			// release even if the caller's context is already cancelled
+			releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err := l.collection.DeleteOne(releaseCtx, bson.M{"_id": key, "owner": owner})
+			if err != nil {
+				log.Printf("failed to release lock %s: %v", key, err)
+			}
+		})
+	}, nil
+}
+
+// renew extends the lease until ctx is done, so holders running longer than
+// the TTL keep it. It gives up once the lease is gone, which only happens
+// when renewing failed for a whole TTL and another caller took the key.
+func (l *Locker) renew(ctx context.Context, key string, owner primitive.ObjectID) {
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		filter := bson.M{"_id": key, "owner": owner}
+		update := bson.M{"$set": bson.M{"expires": time.Now().UTC().Add(l.ttl)}}
+		result, err := l.collection.UpdateOne(ctx, filter, update)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to renew lock %s: %v", key, err)
+			}
+			continue
+		}
+		if result.MatchedCount == 0 {
+			log.Printf("lost lock %s, it expired before it could be renewed", key)
+			return
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+type AuditRepo struct {
+	mu      sync.RWMutex
+	entries []models.AuditEntry
+}
+
+func NewAuditRepository() *AuditRepo {
+	return &AuditRepo{}
+}
+
+func (r *AuditRepo) AddAuditEntry(ctx context.Context, entry models.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry.ID = newID()
+	entry.Changes = append([]models.FieldChange(nil), entry.Changes...)
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *AuditRepo) GetBudgetHistory(ctx context.Context, query models.HistoryQuery) ([]models.AuditEntry, error) {
+	if query.After != nil {
+		if err := validateID(query.After.ID); err != nil {
+			return nil, err
+		}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entries := []models.AuditEntry{}
+	for _, entry := range r.entries {
+		if entry.UserID != query.UserID || entry.BudgetID != query.BudgetID {
+			continue
+		}
+		if query.After != nil && !entryBefore(entry, *query.After) {
+			continue
+		}
+		entry.Changes = append([]models.FieldChange(nil), entry.Changes...)
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entryBefore(entries[j], models.HistoryCursor{ID: entries[i].ID, Time: entries[i].Time})
+	})
+	if len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+	}
+	return entries, nil
+}
+
+// entryBefore reports whether the entry is older than the cursor position,
+// which puts it behind the cursor in the newest first history.
+func entryBefore(entry models.AuditEntry, cursor models.HistoryCursor) bool {
+	if !entry.Time.Equal(cursor.Time) {
+		return entry.Time.Before(cursor.Time)
+	}
+	return entry.ID < cursor.ID
+}
@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func (r *BudgetRepo) GetUserBudgets(ctx context.Context, userID string) ([]models.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	budgets := []models.Budget{}
+	for _, id := range r.order {
+		if budget := r.budgets[id]; budget.UserID == userID {
+			budgets = append(budgets, copyBudget(*budget))
+		}
+	}
+	return budgets, nil
+}
+
+func (r *BudgetRepo) InsertBudgets(ctx context.Context, budgets []models.Budget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, budget := range budgets {
+		if err := validateID(budget.ID); err != nil {
+			return err
+		}
+		_, exists := r.budgets[budget.ID]
+		for _, other := range budgets[:i] {
+			exists = exists || other.ID == budget.ID
+		}
+		if exists {
+			return apperrors.AlreadyExists("a budget of the backup already exists")
+		}
+	}
+	for _, budget := range budgets {
+		budget = copyBudget(budget)
+		r.budgets[budget.ID] = &budget
+		r.order = append(r.order, budget.ID)
+	}
+	return nil
+}
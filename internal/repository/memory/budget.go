@@ -0,0 +1,270 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+type BudgetRepo struct {
+	mu      sync.RWMutex
+	budgets map[string]*models.Budget
+	order   []string
+}
+
+func NewBudgetRepository() *BudgetRepo {
+	return &BudgetRepo{budgets: make(map[string]*models.Budget)}
+}
+
+func (r *BudgetRepo) AddBudget(ctx context.Context, budget models.Budget) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget = copyBudget(budget)
+	budget.ID = newID()
+	budget.Version = 1
+	r.budgets[budget.ID] = &budget
+	r.order = append(r.order, budget.ID)
+	return budget.ID, nil
+}
+
+func (r *BudgetRepo) GetBudget(ctx context.Context, userID, budgetID string) (*models.Budget, error) {
+	if err := validateID(budgetID); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored, ok := r.budgets[budgetID]
+	if !ok || stored.DeletedAt != nil || stored.RoleOf(userID) == "" {
+		return nil, nil
+	}
+	budget := activeCopy(*stored)
+	return &budget, nil
+}
+
+func (r *BudgetRepo) GetBudgetList(ctx context.Context, userID string) ([]models.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	budgets := []models.Budget{}
+	for _, id := range r.order {
+		if budget := r.budgets[id]; budget.UserID == userID && budget.DeletedAt == nil {
+			budgets = append(budgets, activeCopy(*budget))
+		}
+	}
+	return budgets, nil
+}
+
+func (r *BudgetRepo) ListBudgets(ctx context.Context, query models.BudgetQuery) ([]models.Budget, error) {
+	if query.After != nil {
+		if err := validateID(query.After.ID); err != nil {
+			return nil, err
+		}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	budgets := []models.Budget{}
+	for _, id := range r.order {
+		if budget := r.budgets[id]; matchesQuery(*budget, query) {
+			budgets = append(budgets, activeCopy(*budget))
+		}
+	}
+	sort.Slice(budgets, func(i, j int) bool {
+		return compareBudgets(budgets[i], cursorOf(budgets[j]), query) < 0
+	})
+	if query.Limit > 0 && len(budgets) > query.Limit {
+		budgets = budgets[:query.Limit]
+	}
+	return budgets, nil
+}
+
+func matchesQuery(budget models.Budget, query models.BudgetQuery) bool {
+	if budget.RoleOf(query.UserID) == "" || budget.DeletedAt != nil {
+		return false
+	}
+	if query.StartsBefore != nil && !budget.StartDate.Before(*query.StartsBefore) {
+		return false
+	}
+	if query.EndsNotBefore != nil && budget.EndDate.Before(*query.EndsNotBefore) {
+		return false
+	}
+	if !strings.HasPrefix(budget.Name, query.NamePrefix) {
+		return false
+	}
+	if query.Category != "" && !slices.ContainsFunc(budget.Category, func(c models.Category) bool {
+		return c.Name == query.Category && c.DeletedAt == nil
+	}) {
+		return false
+	}
+	return query.After == nil || compareBudgets(budget, *query.After, query) > 0
+}
+
+func cursorOf(budget models.Budget) models.BudgetCursor {
+	return models.BudgetCursor{ID: budget.ID, Start: budget.StartDate, Name: budget.Name, Limit: budget.Limit}
+}
+
+// compareBudgets orders budget relative to a cursor position the way the
+// query lists them, so descending queries flip the result.
+func compareBudgets(budget models.Budget, cursor models.BudgetCursor, query models.BudgetQuery) int {
+	var result int
+	switch query.OrderBy {
+	case models.BudgetOrderName:
+		result = strings.Compare(budget.Name, cursor.Name)
+	case models.BudgetOrderLimit:
+		result = cmp.Compare(budget.Limit, cursor.Limit)
+	default:
+		result = budget.StartDate.Compare(cursor.Start)
+	}
+	if result == 0 {
+		result = strings.Compare(budget.ID, cursor.ID)
+	}
+	if query.Descending {
+		result = -result
+	}
+	return result
+}
+
+func (r *BudgetRepo) AddCategory(ctx context.Context, categ models.CreateCategory, version int64) error {
+	if err := validateID(categ.BudgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, ok := r.find(categ.UserID, categ.BudgetID)
+	if ok && slices.ContainsFunc(budget.Category, func(c models.Category) bool {
+		return c.Name == categ.Name && c.DeletedAt == nil
+	}) {
+		return apperrors.AlreadyExists("category with name %s is already added to this budget", categ.Name)
+	}
+	budget, err := r.findAt(categ.UserID, categ.BudgetID, version)
+	if err != nil {
+		return err
+	}
+	budget.Category = append(budget.Category, copyCategory(models.Category{
+		ID:       newID(),
+		Name:     categ.Name,
+		Limit:    categ.Limit,
+		Rollover: categ.Rollover,
+	}))
+	budget.Version++
+	return nil
+}
+
+func (r *BudgetRepo) DeleteCategory(ctx context.Context, userID, budgetID, catID string, version int64) error {
+	if err := validateID(budgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, err := r.findAt(userID, budgetID, version)
+	if err != nil {
+		return err
+	}
+	for i := range budget.Category {
+		if budget.Category[i].ID == catID && budget.Category[i].DeletedAt == nil {
+			now := time.Now().UTC()
+			budget.Category[i].DeletedAt = &now
+			budget.Version++
+			return nil
+		}
+	}
+	return apperrors.NotFound("category is not found")
+}
+
+func (r *BudgetRepo) DeleteBudget(ctx context.Context, userID, budgetID string, version int64) error {
+	if err := validateID(budgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, err := r.findAt(userID, budgetID, version)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	budget.DeletedAt = &now
+	budget.Version++
+	return nil
+}
+
+func (r *BudgetRepo) UpdateBudget(ctx context.Context, updates models.Budget) error {
+	if err := validateID(updates.ID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, err := r.findAt(updates.UserID, updates.ID, updates.Version)
+	if err != nil {
+		return err
+	}
+	budget.Name = updates.Name
+	budget.Limit = updates.Limit
+	budget.StartDate = updates.StartDate
+	budget.EndDate = updates.EndDate
+	budget.Allocation = updates.Allocation
+	budget.Version++
+	return nil
+}
+
+func (r *BudgetRepo) UpdateCategory(ctx context.Context, userID, budgetID string, updates models.Category, version int64) error {
+	if err := validateID(budgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, err := r.findAt(userID, budgetID, version)
+	if err != nil {
+		return err
+	}
+	for i := range budget.Category {
+		if budget.Category[i].ID == updates.ID && budget.Category[i].DeletedAt == nil {
+			updates.ID = budget.Category[i].ID
+			budget.Category[i] = copyCategory(updates)
+			budget.Version++
+			return nil
+		}
+	}
+	return apperrors.NotFound("category is not found")
+}
+
+func (r *BudgetRepo) UpdateMembers(ctx context.Context, userID, budgetID string, members []models.Member, version int64) error {
+	if err := validateID(budgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, err := r.findAt(userID, budgetID, version)
+	if err != nil {
+		return err
+	}
+	budget.Members = slices.Clone(members)
+	budget.Version++
+	return nil
+}
+
+// findAt finds a budget that is still at version. It must be called with
+// r.mu held.
+func (r *BudgetRepo) findAt(userID, budgetID string, version int64) (*models.Budget, error) {
+	budget, ok := r.find(userID, budgetID)
+	if !ok {
+		return nil, apperrors.NotFound("budget is not found")
+	}
+	if budget.Version != version {
+		return nil, apperrors.Conflict("budget was modified concurrently, current version is %d", budget.Version)
+	}
+	return budget, nil
+}
+
+// find skips deleted budgets. It must be called with r.mu held.
+func (r *BudgetRepo) find(userID, budgetID string) (*models.Budget, bool) {
+	budget, ok := r.budgets[budgetID]
+	if !ok || budget.UserID != userID || budget.DeletedAt != nil {
+		return nil, false
+	}
+	return budget, true
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository/repotest"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func TestBudgetRepository(t *testing.T) {
+	repotest.RunBudgetRepository(t, func(t *testing.T) service.BudgetRepository {
+		return NewBudgetRepository()
+	})
+}
+
+func TestExpenseRepository(t *testing.T) {
+	repotest.RunExpenseRepository(t, func(t *testing.T) service.ExpenseRepository {
+		return NewExpenseRepository()
+	})
+}
+
+func TestAuditRepository(t *testing.T) {
+	repotest.RunAuditRepository(t, func(t *testing.T) service.AuditRepository {
+		return NewAuditRepository()
+	})
+}
+
+func TestOutboxRepository(t *testing.T) {
+	repotest.RunOutboxRepository(t, func(t *testing.T) service.OutboxRepository {
+		return NewOutboxRepository()
+	})
+}
+
+func TestTemplateRepository(t *testing.T) {
+	repotest.RunTemplateRepository(t, func(t *testing.T) service.TemplateRepository {
+		return NewTemplateRepository()
+	})
+}
+
+func TestLocker(t *testing.T) {
+	repotest.RunLocker(t, func(t *testing.T) service.Locker {
+		return NewLocker()
+	})
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	repotest.RunConcurrentWrites(t,
+		func(t *testing.T) service.BudgetRepository { return NewBudgetRepository() },
+		func(t *testing.T) service.Locker { return NewLocker() },
+	)
+}
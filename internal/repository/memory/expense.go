@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+type ExpenseRepo struct {
+	mu       sync.RWMutex
+	expenses map[string]models.Expense
+}
+
+func NewExpenseRepository() *ExpenseRepo {
+	return &ExpenseRepo{expenses: make(map[string]models.Expense)}
+}
+
+func (r *ExpenseRepo) AddExpense(ctx context.Context, expense models.Expense) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	expense.ID = newID()
+	r.expenses[expense.ID] = copyExpense(expense)
+	return expense.ID, nil
+}
+
+func (r *ExpenseRepo) GetExpense(ctx context.Context, expenseID string) (*models.Expense, error) {
+	if err := validateID(expenseID); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	expense, ok := r.expenses[expenseID]
+	if !ok {
+		return nil, nil
+	}
+	expense = copyExpense(expense)
+	return &expense, nil
+}
+
+func (r *ExpenseRepo) GetExpenses(ctx context.Context, userID, budgetID, categoryID string) ([]models.Expense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	expenses := []models.Expense{}
+	for _, expense := range r.expenses {
+		if expense.UserID != userID || expense.BudgetID != budgetID {
+			continue
+		}
+		if categoryID != "" && expense.CategoryID != categoryID {
+			continue
+		}
+		expenses = append(expenses, copyExpense(expense))
+	}
+	sort.SliceStable(expenses, func(i, j int) bool {
+		if expenses[i].Date.Equal(expenses[j].Date) {
+			return expenses[i].ID < expenses[j].ID
+		}
+		return expenses[i].Date.Before(expenses[j].Date)
+	})
+	return expenses, nil
+}
+
+func (r *ExpenseRepo) DeleteExpense(ctx context.Context, userID, expenseID string) error {
+	if err := validateID(expenseID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	expense, ok := r.expenses[expenseID]
+	if !ok || expense.UserID != userID {
+		return apperrors.NotFound("expense is not found")
+	}
+	delete(r.expenses, expenseID)
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package memory provides concurrency-safe in-memory implementations of the
+// repositories, mirroring the behaviour of the MongoDB ones. It is meant for
+// local development and tests.
+package memory
+
+import (
+	"slices"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+func validateID(id string) error {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return apperrors.InvalidArgument("invalid id: %s", id)
+	}
+	return nil
+}
+
+// copyBudget deep-copies the budget and assigns IDs to the categories that do
+// not have one yet, like the MongoDB repository does on insert.
+func copyBudget(budget models.Budget) models.Budget {
+	categories := make([]models.Category, len(budget.Category))
+	for i, categ := range budget.Category {
+		if categ.ID == "" {
+			categ.ID = newID()
+		}
+		categories[i] = copyCategory(categ)
+	}
+	budget.Category = categories
+	budget.Members = slices.Clone(budget.Members)
+	if budget.Recurrence != nil {
+		recurrence := *budget.Recurrence
+		budget.Recurrence = &recurrence
+	}
+	return budget
+}
+
+// activeCopy copies a budget the way reads return it, without the categories
+// in the trash.
+func activeCopy(budget models.Budget) models.Budget {
+	budget = copyBudget(budget)
+	categories := budget.Category[:0]
+	for _, categ := range budget.Category {
+		if categ.DeletedAt == nil {
+			categories = append(categories, categ)
+		}
+	}
+	budget.Category = categories
+	return budget
+}
+
+func copyCategory(categ models.Category) models.Category {
+	if categ.Rollover != nil {
+		rollover := *categ.Rollover
+		categ.Rollover = &rollover
+	}
+	return categ
+}
+
+func copyExpense(expense models.Expense) models.Expense {
+	if expense.Original != nil {
+		original := *expense.Original
+		expense.Original = &original
+	}
+	return expense
+}
+
+func copyTemplate(template models.Template) models.Template {
+	categories := make([]models.TemplateCategory, len(template.Categories))
+	for i, categ := range template.Categories {
+		if categ.Rollover != nil {
+			rollover := *categ.Rollover
+			categ.Rollover = &rollover
+		}
+		categories[i] = categ
+	}
+	template.Categories = categories
+	return template
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// Transactor runs functions directly. The in-memory repositories have no
+// transactions, their writes are applied as they are made.
+type Transactor struct{}
+
+func NewTransactor() Transactor {
+	return Transactor{}
+}
+
+func (Transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type OutboxRepo struct {
+	mu     sync.Mutex
+	events map[string]*models.Event
+	order  []string
+}
+
+func NewOutboxRepository() *OutboxRepo {
+	return &OutboxRepo{events: make(map[string]*models.Event)}
+}
+
+func (r *OutboxRepo) AddEvents(ctx context.Context, events []models.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range events {
+		event.ID = newID()
+		event.Changes = append([]models.FieldChange(nil), event.Changes...)
+		r.events[event.ID] = &event
+		r.order = append(r.order, event.ID)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := []models.Event{}
+	for _, id := range r.order {
+		if len(events) == limit {
+			break
+		}
+		event := r.events[id]
+		if event.DeadLetter || event.NextAttempt.After(now) {
+			continue
+		}
+		event.NextAttempt = now.Add(lease)
+		event.Attempts++
+		claimed := *event
+		claimed.Changes = append([]models.FieldChange(nil), event.Changes...)
+		events = append(events, claimed)
+	}
+	return events, nil
+}
+
+func (r *OutboxRepo) DeleteEvent(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.events, id)
+	for i, eventID := range r.order {
+		if eventID == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *OutboxRepo) FailEvent(ctx context.Context, id string, next time.Time, lastErr string, deadLetter bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if event, ok := r.events[id]; ok {
+		event.NextAttempt = next
+		event.LastError = lastErr
+		event.DeadLetter = deadLetter
+	}
+	return nil
+}
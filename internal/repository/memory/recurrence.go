@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func (r *BudgetRepo) GetDueRecurringBudgets(ctx context.Context, now time.Time) ([]models.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	budgets := []models.Budget{}
+	for _, id := range r.order {
+		budget := r.budgets[id]
+		if budget.Recurrence == nil || !budget.Recurrence.Active || budget.NextID != "" || budget.EndDate.After(now) || budget.DeletedAt != nil {
+			continue
+		}
+		budgets = append(budgets, activeCopy(*budget))
+	}
+	return budgets, nil
+}
+
+func (r *BudgetRepo) RenewBudget(ctx context.Context, budgetID string, next models.Budget) (string, error) {
+	if err := validateID(budgetID); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, ok := r.budgets[budgetID]
+	if !ok || budget.NextID != "" || budget.DeletedAt != nil {
+		return "", apperrors.Conflict("budget is already renewed")
+	}
+	next = copyBudget(next)
+	next.ID = newID()
+	next.Version = 1
+	r.budgets[next.ID] = &next
+	r.order = append(r.order, next.ID)
+	budget.NextID = next.ID
+	budget.Version++
+	return next.ID, nil
+}
+
+func (r *BudgetRepo) GetBudgetSeries(ctx context.Context, userID, seriesID string) ([]models.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	budgets := []models.Budget{}
+	for _, id := range r.order {
+		budget := r.budgets[id]
+		if budget.RoleOf(userID) != "" && budget.SeriesID != "" && budget.SeriesID == seriesID && budget.DeletedAt == nil {
+			budgets = append(budgets, activeCopy(*budget))
+		}
+	}
+	sort.SliceStable(budgets, func(i, j int) bool {
+		return budgets[i].StartDate.Before(budgets[j].StartDate)
+	})
+	return budgets, nil
+}
+
+func (r *BudgetRepo) StopRecurrence(ctx context.Context, userID, seriesID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	matched := false
+	for _, budget := range r.budgets {
+		if budget.UserID != userID || budget.SeriesID == "" || budget.SeriesID != seriesID {
+			continue
+		}
+		matched = true
+		if budget.Recurrence != nil {
+			budget.Recurrence.Active = false
+		}
+		budget.Version++
+	}
+	if !matched {
+		return apperrors.NotFound("budget series is not found")
+	}
+	return nil
+}
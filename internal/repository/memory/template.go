@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+type TemplateRepo struct {
+	mu        sync.RWMutex
+	templates map[string]models.Template
+}
+
+func NewTemplateRepository() *TemplateRepo {
+	return &TemplateRepo{templates: make(map[string]models.Template)}
+}
+
+func (r *TemplateRepo) AddTemplate(ctx context.Context, template models.Template) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.nameTaken(template) {
+		return "", apperrors.AlreadyExists("template with name %s already exists", template.Name)
+	}
+	template.ID = newID()
+	r.templates[template.ID] = copyTemplate(template)
+	return template.ID, nil
+}
+
+func (r *TemplateRepo) GetTemplate(ctx context.Context, userID, templateID string) (*models.Template, error) {
+	if err := validateID(templateID); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	template, ok := r.templates[templateID]
+	if !ok || template.UserID != userID {
+		return nil, nil
+	}
+	template = copyTemplate(template)
+	return &template, nil
+}
+
+func (r *TemplateRepo) ListTemplates(ctx context.Context, userID string) ([]models.Template, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	templates := []models.Template{}
+	for _, template := range r.templates {
+		if template.UserID == userID {
+			templates = append(templates, copyTemplate(template))
+		}
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+func (r *TemplateRepo) UpdateTemplate(ctx context.Context, template models.Template) error {
+	if err := validateID(template.ID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.templates[template.ID]
+	if !ok || stored.UserID != template.UserID {
+		return apperrors.NotFound("template is not found")
+	}
+	if r.nameTaken(template) {
+		return apperrors.AlreadyExists("template with name %s already exists", template.Name)
+	}
+	template.CreatedAt = stored.CreatedAt
+	r.templates[template.ID] = copyTemplate(template)
+	return nil
+}
+
+func (r *TemplateRepo) DeleteTemplate(ctx context.Context, userID, templateID string) error {
+	if err := validateID(templateID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	template, ok := r.templates[templateID]
+	if !ok || template.UserID != userID {
+		return apperrors.NotFound("template is not found")
+	}
+	delete(r.templates, templateID)
+	return nil
+}
+
+// nameTaken reports whether another template of the user has the name of
+// template. It must be called with r.mu held.
+func (r *TemplateRepo) nameTaken(template models.Template) bool {
+	for id, stored := range r.templates {
+		if id != template.ID && stored.UserID == template.UserID && stored.Name == template.Name {
+			return true
+		}
+	}
+	return false
+}
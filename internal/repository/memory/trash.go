@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func (r *BudgetRepo) GetDeletedBudgets(ctx context.Context, userID string) ([]models.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	budgets := []models.Budget{}
+	for _, id := range r.order {
+		if budget := r.budgets[id]; budget.UserID == userID && budget.DeletedAt != nil {
+			budgets = append(budgets, activeCopy(*budget))
+		}
+	}
+	sort.SliceStable(budgets, func(i, j int) bool {
+		return budgets[i].DeletedAt.After(*budgets[j].DeletedAt)
+	})
+	return budgets, nil
+}
+
+func (r *BudgetRepo) GetDeletedCategories(ctx context.Context, userID string) ([]models.DeletedCategory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	categories := []models.DeletedCategory{}
+	for _, id := range r.order {
+		budget := r.budgets[id]
+		if budget.UserID != userID || budget.DeletedAt != nil {
+			continue
+		}
+		for _, categ := range budget.Category {
+			if categ.DeletedAt != nil {
+				categories = append(categories, models.DeletedCategory{BudgetID: budget.ID, Category: copyCategory(categ)})
+			}
+		}
+	}
+	return categories, nil
+}
+
+func (r *BudgetRepo) RestoreBudget(ctx context.Context, userID, budgetID string, version int64) error {
+	if err := validateID(budgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, ok := r.budgets[budgetID]
+	if !ok || budget.UserID != userID || budget.DeletedAt == nil {
+		return apperrors.NotFound("deleted budget is not found")
+	}
+	if budget.Version != version {
+		return apperrors.Conflict("budget was modified concurrently, current version is %d", budget.Version)
+	}
+	budget.DeletedAt = nil
+	budget.Version++
+	return nil
+}
+
+func (r *BudgetRepo) RestoreCategory(ctx context.Context, userID, budgetID, catID string, version int64) error {
+	if err := validateID(budgetID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	budget, err := r.findAt(userID, budgetID, version)
+	if err != nil {
+		return err
+	}
+	for i := range budget.Category {
+		if budget.Category[i].ID == catID && budget.Category[i].DeletedAt != nil {
+			budget.Category[i].DeletedAt = nil
+			budget.Version++
+			return nil
+		}
+	}
+	return apperrors.NotFound("deleted category is not found")
+}
+
+func (r *BudgetRepo) PurgeDeleted(ctx context.Context, before time.Time) ([]models.Budget, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	purged := []models.Budget{}
+	order := r.order[:0]
+	for _, id := range r.order {
+		budget := r.budgets[id]
+		if budget.DeletedAt != nil && budget.DeletedAt.Before(before) {
+			delete(r.budgets, id)
+			purged = append(purged, activeCopy(*budget))
+			continue
+		}
+		order = append(order, id)
+		categories := budget.Category[:0]
+		for _, categ := range budget.Category {
+			if categ.DeletedAt == nil || !categ.DeletedAt.Before(before) {
+				categories = append(categories, categ)
+			}
+		}
+		if len(categories) != len(budget.Category) {
+			budget.Category = categories
+			budget.Version++
+		}
+	}
+	r.order = order
+	return purged, nil
+}
+
+func (r *ExpenseRepo) DeleteBudgetExpenses(ctx context.Context, budgetIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	purged := make(map[string]bool, len(budgetIDs))
+	for _, id := range budgetIDs {
+		purged[id] = true
+	}
+	for id, expense := range r.expenses {
+		if purged[expense.BudgetID] {
+			delete(r.expenses, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"log"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MigrateMoneyToCents converts limits and amounts stored as doubles by
+// earlier versions into integer cents. Only double values are touched, so it
+// is safe to run on every start.
+func MigrateMoneyToCents(ctx context.Context, db *mongo.Database, budgetCollection, expenseCollection string) error {
+	budgets := db.Collection(budgetCollection)
+	filter := bson.M{"$or": bson.A{
+		bson.M{"limit": bson.M{"$type": "double"}},
+		bson.M{"categories.limit": bson.M{"$type": "double"}},
+		bson.M{"categories.carried": bson.M{"$type": "double"}},
+		bson.M{"categories.rollover.cap": bson.M{"$type": "double"}},
+	}}
+	categoryToCents := bson.M{"$mergeObjects": bson.A{
+		"$$c",
+		bson.M{
+			"limit":   centsExpr("$$c.limit"),
+			"carried": centsExpr("$$c.carried"),
+			"rollover": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$type": "$$c.rollover"}, "object"}},
+				bson.M{"$mergeObjects": bson.A{"$$c.rollover", bson.M{"cap": centsExpr("$$c.rollover.cap")}}},
+				"$$c.rollover",
+			}},
+		},
+	}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"limit": centsExpr("$limit"),
+		"categories": bson.M{"$map": bson.M{
+			"input": "$categories",
+			"as":    "c",
+			"in":    categoryToCents,
+		}},
+	}}}}
+	result, err := budgets.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount > 0 {
+		log.Printf("migrated money fields of %d budgets to cents", result.ModifiedCount)
+	}
+
+	expenses := db.Collection(expenseCollection)
+	update = mongo.Pipeline{{{Key: "$set", Value: bson.M{"amount": centsExpr("$amount")}}}}
+	result, err = expenses.UpdateMany(ctx, bson.M{"amount": bson.M{"$type": "double"}}, update)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount > 0 {
+		log.Printf("migrated amounts of %d expenses to cents", result.ModifiedCount)
+	}
+	return nil
+}
+
+// centsExpr converts a double field to rounded integer cents and leaves any
+// other value, including a missing field, as it is.
+func centsExpr(field string) bson.M {
+	return bson.M{"$cond": bson.A{
+		bson.M{"$eq": bson.A{bson.M{"$type": field}, "double"}},
+		bson.M{"$toLong": bson.M{"$round": bson.A{bson.M{"$multiply": bson.A{field, models.CentsPerUnit}}, 0}}},
+		field,
+	}}
+}
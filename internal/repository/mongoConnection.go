@@ -3,22 +3,21 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/config"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-const (
-	dbname                = "mkbudgets"
-	budgetCollection      = "budgets"
-
-)
-
-func CreateMongoClient(ctx context.Context) *mongo.Client {
-	dbURI := "mongodb://localhost:27019"
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(dbURI))
+func CreateMongoClient(ctx context.Context, cfg config.Mongo) *mongo.Client {
+	ctx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.URI).SetConnectTimeout(cfg.ConnectTimeout))
 	if err != nil {
 		log.Fatalf("Failed to create MongoDB client: %v", err)
 	}
@@ -35,10 +34,30 @@ func convertToObjectIDs(ids ...string) ([]primitive.ObjectID, error) {
 	for _, id := range ids {
 		oid, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, errors.New("InvalidID: " + id)
+			return nil, apperrors.InvalidArgument("invalid id: %s", id)
 		}
 		objectIDs = append(objectIDs, oid)
 	}
 
 	return objectIDs, nil
 }
+
+// RequireTransactions fails unless the server supports multi-document
+// transactions, which the Transactor needs for every change. That takes a
+// replica set, a single node one is enough, or a sharded cluster; on a
+// standalone server every write would fail.
+func RequireTransactions(ctx context.Context, client *mongo.Client) error {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return fmt.Errorf("checking the MongoDB deployment: %w", err)
+	}
+	if hello.SetName == "" && hello.Msg != "isdbgrid" {
+		return errors.New("MongoDB is a standalone server, but transactions need a replica set: " +
+			"start mongod with --replSet and run rs.initiate(), or use the memory storage driver")
+	}
+	return nil
+}
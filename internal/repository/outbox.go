@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Transactor runs functions in MongoDB transactions. The repositories join
+// the transaction through the session carried by the context, which requires
+// MongoDB to run as a replica set.
+type Transactor struct {
+	client *mongo.Client
+}
+
+func NewTransactor(client *mongo.Client) *Transactor {
+	return &Transactor{client: client}
+}
+
+// WithTransaction commits the writes of fn if it succeeds. Transient errors
+// make the driver run fn again, so fn must not keep state between runs.
+func (t *Transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (any, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
+
+type OutboxRepo struct {
+	collection *mongo.Collection
+}
+
+func NewOutboxRepository(db *mongo.Database, collection string) *OutboxRepo {
+	return &OutboxRepo{
+		collection: db.Collection(collection),
+	}
+}
+
+func (r *OutboxRepo) AddEvents(ctx context.Context, events []models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	docs := make([]any, len(events))
+	for i, event := range events {
+		docs[i] = event
+	}
+	_, err := r.collection.InsertMany(ctx, docs)
+	return err
+}
+
+// ClaimEvents claims the events one by one, in the order they were written,
+// so concurrent relays never get the same event.
+func (r *OutboxRepo) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Event, error) {
+	filter := bson.M{"dead_letter": bson.M{"$ne": true}, "next_attempt": bson.M{"$lte": now}}
+	update := bson.M{
+		"$set": bson.M{"next_attempt": now.Add(lease)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+	events := []models.Event{}
+	for len(events) < limit {
+		var event models.Event
+		err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *OutboxRepo) DeleteEvent(ctx context.Context, id string) error {
+	oid, err := convertToObjectIDs(id)
+	if err != nil {
+		return err
+	}
+	_, err = r.collection.DeleteOne(ctx, bson.M{"_id": oid[0]})
+	return err
+}
+
+func (r *OutboxRepo) FailEvent(ctx context.Context, id string, next time.Time, lastErr string, deadLetter bool) error {
+	oid, err := convertToObjectIDs(id)
+	if err != nil {
+		return err
+	}
+	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": oid[0]}, bson.M{"$set": bson.M{
+		"next_attempt": next,
+		"last_error":   lastErr,
+		"dead_letter":  deadLetter,
+	}})
+	return err
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *BudgetRepo) GetDueRecurringBudgets(ctx context.Context, now time.Time) ([]models.Budget, error) {
+	budgets := []models.Budget{}
+	filter := bson.M{
+		"recurrence.active": true,
+		"next_id":           bson.M{"$exists": false},
+		"end":               bson.M{"$lte": now},
+		"deleted_at":        nil,
+	}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &budgets)
+	if err != nil {
+		return nil, err
+	}
+	return withActiveCategories(budgets), nil
+}
+
+// RenewBudget claims the budget by setting its next_id and then inserts the
+// next instance under that ID. Only one caller can claim a budget; the others
+// get a conflict error.
+func (r *BudgetRepo) RenewBudget(ctx context.Context, budgetID string, next models.Budget) (string, error) {
+	oid, err := convertToObjectIDs(budgetID)
+	if err != nil {
+		return "", err
+	}
+	nextID := primitive.NewObjectID()
+	filter := bson.M{"_id": oid[0], "next_id": bson.M{"$exists": false}, "deleted_at": nil}
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{"next_id": nextID.Hex()},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.MatchedCount == 0 {
+		return "", apperrors.Conflict("budget is already renewed")
+	}
+
+	next.Category = withCategoryIDs(next.Category)
+	doc, err := toDocument(next)
+	if err != nil {
+		return "", err
+	}
+	doc["_id"] = nextID
+	doc["version"] = int64(1)
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		// release the claim so the renewal is retried
+		_, rollbackErr := r.collection.UpdateOne(ctx, bson.M{"_id": oid[0]}, bson.M{
+			"$unset": bson.M{"next_id": ""},
+			"$inc":   bson.M{"version": 1},
+		})
+		if rollbackErr != nil {
+			return "", rollbackErr
+		}
+		return "", err
+	}
+	return nextID.Hex(), nil
+}
+
+func (r *BudgetRepo) GetBudgetSeries(ctx context.Context, userID, seriesID string) ([]models.Budget, error) {
+	budgets := []models.Budget{}
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+	filter := accessFilter(userID)
+	filter["series_id"], filter["deleted_at"] = seriesID, nil
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &budgets)
+	if err != nil {
+		return nil, err
+	}
+	return withActiveCategories(budgets), nil
+}
+
+func (r *BudgetRepo) StopRecurrence(ctx context.Context, userID, seriesID string) error {
+	filter := bson.M{"user_id": userID, "series_id": seriesID}
+	result, err := r.collection.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"recurrence.active": false},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return apperrors.NotFound("budget series is not found")
+	}
+	return nil
+}
+
+// toDocument marshals v into a bson.M so fields such as _id can be replaced
+// before inserting.
+func toDocument(v any) (bson.M, error) {
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
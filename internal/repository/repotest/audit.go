@@ -0,0 +1,88 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func RunAuditRepository(t *testing.T, newRepo func(t *testing.T) service.AuditRepository) {
+	ctx := context.Background()
+	const budgetID = "650000000000000000000001"
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	add := func(t *testing.T, repo service.AuditRepository, userID string, at time.Time, rpc string) {
+		t.Helper()
+		err := repo.AddAuditEntry(ctx, models.AuditEntry{
+			UserID:    userID,
+			BudgetID:  budgetID,
+			Actor:     userID,
+			RPC:       rpc,
+			RequestID: "request",
+			Time:      at,
+			Changes:   []models.FieldChange{{Field: "name", Before: "old", After: "new"}},
+		})
+		requireNoError(t, err)
+	}
+
+	t.Run("AddAndList", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		add(t, repo, userID, base, "UpdateBudget")
+
+		entries, err := repo.GetBudgetHistory(ctx, models.HistoryQuery{UserID: userID, BudgetID: budgetID, Limit: 10})
+		requireNoError(t, err)
+		if len(entries) != 1 {
+			t.Fatalf("expected 1 entry, got %d", len(entries))
+		}
+		entry := entries[0]
+		requireHexID(t, entry.ID)
+		if entry.RPC != "UpdateBudget" || entry.Actor != userID || entry.RequestID != "request" || !entry.Time.Equal(base) {
+			t.Fatalf("unexpected entry %+v", entry)
+		}
+		if len(entry.Changes) != 1 || entry.Changes[0] != (models.FieldChange{Field: "name", Before: "old", After: "new"}) {
+			t.Fatalf("unexpected changes %+v", entry.Changes)
+		}
+
+		entries, err = repo.GetBudgetHistory(ctx, models.HistoryQuery{UserID: newUserID(), BudgetID: budgetID, Limit: 10})
+		requireNoError(t, err)
+		if entries == nil || len(entries) != 0 {
+			t.Fatalf("expected empty non-nil list, got %#v", entries)
+		}
+	})
+
+	t.Run("NewestFirstPages", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		// two entries share a timestamp, the ID breaks the tie
+		add(t, repo, userID, base, "AddBudget")
+		add(t, repo, userID, base.Add(time.Second), "AddCategory")
+		add(t, repo, userID, base.Add(time.Second), "UpdateCategory")
+		add(t, repo, userID, base.Add(2*time.Second), "DeleteBudget")
+
+		query := models.HistoryQuery{UserID: userID, BudgetID: budgetID, Limit: 3}
+		first, err := repo.GetBudgetHistory(ctx, query)
+		requireNoError(t, err)
+		if len(first) != 3 || first[0].RPC != "DeleteBudget" {
+			t.Fatalf("unexpected first page %+v", first)
+		}
+		last := first[len(first)-1]
+		query.After = &models.HistoryCursor{ID: last.ID, Time: last.Time}
+		second, err := repo.GetBudgetHistory(ctx, query)
+		requireNoError(t, err)
+		if len(second) != 1 || second[0].RPC != "AddBudget" {
+			t.Fatalf("unexpected second page %+v", second)
+		}
+		if first[1].Time.Equal(first[2].Time) && first[1].ID < first[2].ID {
+			t.Fatal("entries with the same time must be ordered by descending ID")
+		}
+
+		query.After = &models.HistoryCursor{ID: "bad", Time: base}
+		_, err = repo.GetBudgetHistory(ctx, query)
+		requireKind(t, err, apperrors.ErrInvalidArgument)
+	})
+}
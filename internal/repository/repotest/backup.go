@@ -0,0 +1,108 @@
+package repotest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func runBackup(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository) {
+	ctx := context.Background()
+
+	t.Run("GetUserBudgetsIncludesTrash", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		kept, err := repo.AddBudget(ctx, models.Budget{
+			UserID:    userID,
+			Name:      "kept",
+			Limit:     100,
+			StartDate: date(2024, 1, 1),
+			EndDate:   date(2024, 2, 1),
+			Category:  []models.Category{{Name: "food", Limit: 10}, {Name: "fuel", Limit: 20}},
+		})
+		requireNoError(t, err)
+		trashed, err := repo.AddBudget(ctx, models.Budget{
+			UserID:    userID,
+			Name:      "trashed",
+			Limit:     100,
+			StartDate: date(2024, 2, 1),
+			EndDate:   date(2024, 3, 1),
+			Category:  []models.Category{},
+		})
+		requireNoError(t, err)
+		budget, err := repo.GetBudget(ctx, userID, kept)
+		requireNoError(t, err)
+		requireNoError(t, repo.DeleteCategory(ctx, userID, kept, budget.Category[1].ID, 1))
+		requireNoError(t, repo.DeleteBudget(ctx, userID, trashed, 1))
+		_, err = repo.AddBudget(ctx, models.Budget{UserID: newUserID(), Name: "other", Category: []models.Category{}})
+		requireNoError(t, err)
+
+		budgets, err := repo.GetUserBudgets(ctx, userID)
+		requireNoError(t, err)
+		if len(budgets) != 2 || budgets[0].ID != kept || budgets[1].ID != trashed || budgets[1].DeletedAt == nil {
+			t.Fatalf("unexpected budgets %+v", budgets)
+		}
+		if len(budgets[0].Category) != 2 || budgets[0].Category[1].DeletedAt == nil {
+			t.Fatalf("deleted category missing: %+v", budgets[0].Category)
+		}
+	})
+
+	t.Run("InsertBudgetsKeepsIDs", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		deletedAt := date(2024, 3, 1)
+		budgets := []models.Budget{
+			{
+				ID:        newHexID(t),
+				UserID:    userID,
+				Name:      "restored",
+				Limit:     100,
+				StartDate: date(2024, 1, 1),
+				EndDate:   date(2024, 2, 1),
+				Category:  []models.Category{{ID: newHexID(t), Name: "food", Limit: 10, Carried: -5}},
+				Version:   7,
+			},
+			{
+				ID:        newHexID(t),
+				UserID:    userID,
+				Name:      "trashed",
+				StartDate: date(2024, 2, 1),
+				EndDate:   date(2024, 3, 1),
+				Category:  []models.Category{},
+				Version:   2,
+				DeletedAt: &deletedAt,
+			},
+		}
+		requireNoError(t, repo.InsertBudgets(ctx, budgets))
+
+		budget, err := repo.GetBudget(ctx, userID, budgets[0].ID)
+		requireNoError(t, err)
+		if budget == nil || budget.Version != 7 || len(budget.Category) != 1 ||
+			budget.Category[0].ID != budgets[0].Category[0].ID || budget.Category[0].Carried != -5 {
+			t.Fatalf("unexpected restored budget %+v", budget)
+		}
+		deleted, err := repo.GetDeletedBudgets(ctx, userID)
+		requireNoError(t, err)
+		if len(deleted) != 1 || deleted[0].ID != budgets[1].ID {
+			t.Fatalf("unexpected deleted budgets %+v", deleted)
+		}
+
+		requireKind(t, repo.InsertBudgets(ctx, budgets[:1]), apperrors.ErrAlreadyExists)
+		requireKind(t, repo.InsertBudgets(ctx, []models.Budget{{ID: "bad", UserID: userID}}), apperrors.ErrInvalidArgument)
+		requireNoError(t, repo.InsertBudgets(ctx, nil))
+	})
+}
+
+func newHexID(t *testing.T) string {
+	t.Helper()
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,291 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func RunBudgetRepository(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository) {
+	ctx := context.Background()
+
+	newBudget := func(userID string) models.Budget {
+		return models.Budget{
+			UserID:    userID,
+			Name:      "groceries",
+			Limit:     500,
+			StartDate: date(2024, 1, 1),
+			EndDate:   date(2024, 2, 1),
+			Category:  []models.Category{},
+		}
+	}
+	addBudget := func(t *testing.T, repo service.BudgetRepository, userID string) string {
+		t.Helper()
+		id, err := repo.AddBudget(ctx, newBudget(userID))
+		requireNoError(t, err)
+		return id
+	}
+	versionOf := func(t *testing.T, repo service.BudgetRepository, userID, budgetID string) int64 {
+		t.Helper()
+		budget, err := repo.GetBudget(ctx, userID, budgetID)
+		requireNoError(t, err)
+		return budget.Version
+	}
+	addCategory := func(t *testing.T, repo service.BudgetRepository, userID, budgetID, name string) models.Category {
+		t.Helper()
+		version := versionOf(t, repo, userID, budgetID)
+		err := repo.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: budgetID, Name: name, Limit: 100}, version)
+		requireNoError(t, err)
+		budget, err := repo.GetBudget(ctx, userID, budgetID)
+		requireNoError(t, err)
+		return budget.Category[len(budget.Category)-1]
+	}
+
+	t.Run("AddAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		requireHexID(t, id)
+
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if budget == nil {
+			t.Fatal("expected budget, got nil")
+		}
+		want := newBudget(userID)
+		if budget.ID != id || budget.UserID != want.UserID || budget.Name != want.Name || budget.Limit != want.Limit {
+			t.Fatalf("unexpected budget %+v", budget)
+		}
+		if !budget.StartDate.Equal(want.StartDate) || !budget.EndDate.Equal(want.EndDate) {
+			t.Fatalf("unexpected dates %v - %v", budget.StartDate, budget.EndDate)
+		}
+		if len(budget.Category) != 0 {
+			t.Fatalf("expected no categories, got %v", budget.Category)
+		}
+	})
+
+	t.Run("GetMissingReturnsNil", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+
+		budget, err := repo.GetBudget(ctx, userID, missingID)
+		requireNoError(t, err)
+		if budget != nil {
+			t.Fatalf("expected nil budget, got %+v", budget)
+		}
+		budget, err = repo.GetBudget(ctx, newUserID(), id)
+		requireNoError(t, err)
+		if budget != nil {
+			t.Fatalf("budget of another user returned: %+v", budget)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		_, err := repo.GetBudget(ctx, userID, "not-an-id")
+		requireKind(t, err, apperrors.ErrInvalidArgument)
+		err = repo.DeleteBudget(ctx, userID, "not-an-id", 1)
+		requireKind(t, err, apperrors.ErrInvalidArgument)
+		err = repo.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: "not-an-id", Name: "x", Limit: 1}, 1)
+		requireKind(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("ListIsScopedToUser", func(t *testing.T) {
+		repo := newRepo(t)
+		userID, otherID := newUserID(), newUserID()
+		first := addBudget(t, repo, userID)
+		second := addBudget(t, repo, userID)
+		addBudget(t, repo, otherID)
+
+		budgets, err := repo.GetBudgetList(ctx, userID)
+		requireNoError(t, err)
+		if len(budgets) != 2 || budgets[0].ID != first || budgets[1].ID != second {
+			t.Fatalf("unexpected budget list %+v", budgets)
+		}
+		budgets, err = repo.GetBudgetList(ctx, newUserID())
+		requireNoError(t, err)
+		if budgets == nil || len(budgets) != 0 {
+			t.Fatalf("expected empty non-nil list, got %#v", budgets)
+		}
+	})
+
+	t.Run("AddCategory", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		food := addCategory(t, repo, userID, id, "food")
+		drinks := addCategory(t, repo, userID, id, "drinks")
+		requireHexID(t, food.ID)
+		if food.ID == drinks.ID {
+			t.Fatal("category IDs must be unique")
+		}
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if len(budget.Category) != 2 || budget.Category[0].Name != "food" || budget.Category[1].Name != "drinks" {
+			t.Fatalf("categories must be appended in order, got %+v", budget.Category)
+		}
+
+		err = repo.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: missingID, Name: "x", Limit: 1}, 1)
+		requireKind(t, err, apperrors.ErrNotFound)
+		err = repo.AddCategory(ctx, models.CreateCategory{UserID: newUserID(), BudgetID: id, Name: "x", Limit: 1}, budget.Version)
+		requireKind(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("DeleteCategory", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		food := addCategory(t, repo, userID, id, "food")
+		drinks := addCategory(t, repo, userID, id, "drinks")
+
+		requireNoError(t, repo.DeleteCategory(ctx, userID, id, food.ID, versionOf(t, repo, userID, id)))
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if len(budget.Category) != 1 || budget.Category[0].ID != drinks.ID {
+			t.Fatalf("unexpected categories after delete %+v", budget.Category)
+		}
+		err = repo.DeleteCategory(ctx, userID, id, food.ID, budget.Version)
+		requireKind(t, err, apperrors.ErrNotFound)
+		err = repo.DeleteCategory(ctx, userID, missingID, drinks.ID, budget.Version)
+		requireKind(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("DeleteBudget", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+
+		version := versionOf(t, repo, userID, id)
+		err := repo.DeleteBudget(ctx, newUserID(), id, version)
+		requireKind(t, err, apperrors.ErrNotFound)
+		requireNoError(t, repo.DeleteBudget(ctx, userID, id, version))
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if budget != nil {
+			t.Fatalf("deleted budget still returned: %+v", budget)
+		}
+		err = repo.DeleteBudget(ctx, userID, id, version)
+		requireKind(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("UpdateBudget", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		addCategory(t, repo, userID, id, "food")
+
+		updates := models.Budget{
+			ID:         id,
+			UserID:     userID,
+			Name:       "household",
+			Limit:      750,
+			StartDate:  date(2024, 3, 1),
+			EndDate:    date(2024, 4, 1),
+			Allocation: models.AllocationStrict,
+			Version:    versionOf(t, repo, userID, id),
+		}
+		requireNoError(t, repo.UpdateBudget(ctx, updates))
+		// writing identical values again is not an error
+		updates.Version++
+		requireNoError(t, repo.UpdateBudget(ctx, updates))
+
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if budget.Name != "household" || budget.Limit != 750 || !budget.StartDate.Equal(updates.StartDate) || !budget.EndDate.Equal(updates.EndDate) || budget.Allocation != models.AllocationStrict {
+			t.Fatalf("update not applied: %+v", budget)
+		}
+		if len(budget.Category) != 1 {
+			t.Fatalf("update must keep categories, got %+v", budget.Category)
+		}
+
+		updates.ID = missingID
+		requireKind(t, repo.UpdateBudget(ctx, updates), apperrors.ErrNotFound)
+	})
+
+	t.Run("UpdateCategory", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		food := addCategory(t, repo, userID, id, "food")
+		drinks := addCategory(t, repo, userID, id, "drinks")
+
+		food.Name, food.Limit = "meals", 250
+		requireNoError(t, repo.UpdateCategory(ctx, userID, id, food, versionOf(t, repo, userID, id)))
+		requireNoError(t, repo.UpdateCategory(ctx, userID, id, food, versionOf(t, repo, userID, id)))
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if budget.Category[0] != food || budget.Category[1] != drinks {
+			t.Fatalf("unexpected categories after update %+v", budget.Category)
+		}
+
+		err = repo.UpdateCategory(ctx, userID, id, models.Category{ID: missingID, Name: "x"}, budget.Version)
+		requireKind(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("VersionIsIncrementedByChanges", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		if version := versionOf(t, repo, userID, id); version != 1 {
+			t.Fatalf("new budgets must start at version 1, got %d", version)
+		}
+		food := addCategory(t, repo, userID, id, "food")
+		if version := versionOf(t, repo, userID, id); version != 2 {
+			t.Fatalf("expected version 2 after adding a category, got %d", version)
+		}
+		requireNoError(t, repo.UpdateCategory(ctx, userID, id, food, 2))
+		requireNoError(t, repo.DeleteCategory(ctx, userID, id, food.ID, 3))
+		if version := versionOf(t, repo, userID, id); version != 4 {
+			t.Fatalf("expected version 4, got %d", version)
+		}
+	})
+
+	t.Run("StaleVersionConflicts", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		food := addCategory(t, repo, userID, id, "food")
+		stale := versionOf(t, repo, userID, id) - 1
+
+		err := repo.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: id, Name: "x", Limit: 1}, stale)
+		requireKind(t, err, apperrors.ErrConflict)
+		requireKind(t, repo.UpdateCategory(ctx, userID, id, food, stale), apperrors.ErrConflict)
+		requireKind(t, repo.DeleteCategory(ctx, userID, id, food.ID, stale), apperrors.ErrConflict)
+		requireKind(t, repo.UpdateBudget(ctx, models.Budget{ID: id, UserID: userID, Name: "x", Version: stale}), apperrors.ErrConflict)
+		requireKind(t, repo.DeleteBudget(ctx, userID, id, stale), apperrors.ErrConflict)
+
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if budget == nil || budget.Name != "groceries" || len(budget.Category) != 1 || budget.Version != stale+1 {
+			t.Fatalf("stale writes must not be applied, got %+v", budget)
+		}
+	})
+
+	t.Run("ReturnedBudgetIsACopy", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := addBudget(t, repo, userID)
+		addCategory(t, repo, userID, id, "food")
+
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		budget.Name = "changed"
+		budget.Category[0].Name = "changed"
+		budget, err = repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if budget.Name == "changed" || budget.Category[0].Name == "changed" {
+			t.Fatal("mutating a returned budget must not change the stored one")
+		}
+	})
+
+	runRecurrence(t, newRepo)
+	runListBudgets(t, newRepo)
+	runTrash(t, newRepo)
+	runMembers(t, newRepo)
+	runBackup(t, newRepo)
+}
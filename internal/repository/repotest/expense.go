@@ -0,0 +1,136 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func RunExpenseRepository(t *testing.T, newRepo func(t *testing.T) service.ExpenseRepository) {
+	ctx := context.Background()
+	const budgetID = "650000000000000000000001"
+
+	add := func(t *testing.T, repo service.ExpenseRepository, userID, categoryID string, amount models.Money, day int) string {
+		t.Helper()
+		id, err := repo.AddExpense(ctx, models.Expense{
+			UserID:     userID,
+			BudgetID:   budgetID,
+			CategoryID: categoryID,
+			Amount:     amount,
+			Date:       date(2024, 1, day),
+			Note:       "note",
+		})
+		requireNoError(t, err)
+		return id
+	}
+
+	t.Run("AddAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := add(t, repo, userID, "food", 1250, 3)
+		requireHexID(t, id)
+
+		expense, err := repo.GetExpense(ctx, id)
+		requireNoError(t, err)
+		if expense == nil || expense.ID != id || expense.UserID != userID || expense.Amount != 1250 || expense.Note != "note" || !expense.Date.Equal(date(2024, 1, 3)) {
+			t.Fatalf("unexpected expense %+v", expense)
+		}
+		expense, err = repo.GetExpense(ctx, missingID)
+		requireNoError(t, err)
+		if expense != nil {
+			t.Fatalf("expected nil expense, got %+v", expense)
+		}
+		_, err = repo.GetExpense(ctx, "not-an-id")
+		requireKind(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("ForeignAmountIsStored", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id, err := repo.AddExpense(ctx, models.Expense{
+			UserID:     userID,
+			BudgetID:   budgetID,
+			CategoryID: "food",
+			Amount:     1105,
+			Currency:   "USD",
+			Date:       date(2024, 1, 3),
+			Original: &models.ForeignAmount{
+				Amount:   1000,
+				Currency: "EUR",
+				Rate:     "1.105",
+				RateDate: date(2024, 1, 1),
+			},
+		})
+		requireNoError(t, err)
+
+		expense, err := repo.GetExpense(ctx, id)
+		requireNoError(t, err)
+		if expense == nil || expense.Currency != "USD" || expense.Original == nil {
+			t.Fatalf("unexpected expense %+v", expense)
+		}
+		original := *expense.Original
+		if original.Amount != 1000 || original.Currency != "EUR" || original.Rate != "1.105" || !original.RateDate.Equal(date(2024, 1, 1)) {
+			t.Fatalf("unexpected original amount %+v", original)
+		}
+	})
+
+	t.Run("ListSortedByDateAndFiltered", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		third := add(t, repo, userID, "food", 1, 20)
+		first := add(t, repo, userID, "food", 1, 2)
+		second := add(t, repo, userID, "drinks", 1, 10)
+		add(t, repo, newUserID(), "food", 1, 1)
+
+		expenses, err := repo.GetExpenses(ctx, userID, budgetID, "")
+		requireNoError(t, err)
+		if len(expenses) != 3 || expenses[0].ID != first || expenses[1].ID != second || expenses[2].ID != third {
+			t.Fatalf("unexpected expenses %+v", expenses)
+		}
+		expenses, err = repo.GetExpenses(ctx, userID, budgetID, "food")
+		requireNoError(t, err)
+		if len(expenses) != 2 || expenses[0].ID != first || expenses[1].ID != third {
+			t.Fatalf("unexpected food expenses %+v", expenses)
+		}
+		expenses, err = repo.GetExpenses(ctx, userID, missingID, "")
+		requireNoError(t, err)
+		if expenses == nil || len(expenses) != 0 {
+			t.Fatalf("expected empty non-nil list, got %#v", expenses)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id := add(t, repo, userID, "food", 1, 1)
+
+		requireKind(t, repo.DeleteExpense(ctx, newUserID(), id), apperrors.ErrNotFound)
+		requireNoError(t, repo.DeleteExpense(ctx, userID, id))
+		requireKind(t, repo.DeleteExpense(ctx, userID, id), apperrors.ErrNotFound)
+		requireKind(t, repo.DeleteExpense(ctx, userID, "bad"), apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("DeleteBudgetExpenses", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		add(t, repo, userID, "food", 1, 1)
+		other, err := repo.AddExpense(ctx, models.Expense{UserID: userID, BudgetID: missingID, CategoryID: "food", Amount: 1, Date: date(2024, 1, 1)})
+		requireNoError(t, err)
+
+		requireNoError(t, repo.DeleteBudgetExpenses(ctx, []string{budgetID}))
+		expenses, err := repo.GetExpenses(ctx, userID, budgetID, "")
+		requireNoError(t, err)
+		if len(expenses) != 0 {
+			t.Fatalf("expected expenses of the budget to be deleted, got %+v", expenses)
+		}
+		expense, err := repo.GetExpense(ctx, other)
+		requireNoError(t, err)
+		if expense == nil {
+			t.Fatal("expense of another budget was deleted")
+		}
+		requireNoError(t, repo.DeleteBudgetExpenses(ctx, nil))
+	})
+}
@@ -0,0 +1,151 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func runListBudgets(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository) {
+	ctx := context.Background()
+
+	// seed adds four January to April monthly budgets named after their
+	// month; February and March have a "food" category.
+	seed := func(t *testing.T, repo service.BudgetRepository, userID string) []string {
+		t.Helper()
+		names := []string{"jan", "feb", "mar", "apr"}
+		limits := []models.Money{300, 100, 400, 100}
+		ids := make([]string, len(names))
+		for i, name := range names {
+			budget := models.Budget{
+				UserID:    userID,
+				Name:      name,
+				Limit:     limits[i],
+				StartDate: date(2024, time.Month(i+1), 1),
+				EndDate:   date(2024, time.Month(i+2), 1),
+				Category:  []models.Category{},
+			}
+			if name == "feb" || name == "mar" {
+				budget.Category = append(budget.Category, models.Category{Name: "food", Limit: 10})
+			}
+			id, err := repo.AddBudget(ctx, budget)
+			requireNoError(t, err)
+			ids[i] = id
+		}
+		return ids
+	}
+	list := func(t *testing.T, repo service.BudgetRepository, query models.BudgetQuery) []string {
+		t.Helper()
+		if query.OrderBy == "" {
+			query.OrderBy = models.BudgetOrderStart
+		}
+		if query.Limit == 0 {
+			query.Limit = 10
+		}
+		budgets, err := repo.ListBudgets(ctx, query)
+		requireNoError(t, err)
+		names := make([]string, len(budgets))
+		for i, budget := range budgets {
+			names[i] = budget.Name
+		}
+		return names
+	}
+	requireNames := func(t *testing.T, got []string, want ...string) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	}
+	at := func(year int, month time.Month, day int) *time.Time {
+		d := date(year, month, day)
+		return &d
+	}
+
+	t.Run("ListFilters", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		seed(t, repo, userID)
+		seed(t, repo, newUserID())
+
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID}), "jan", "feb", "mar", "apr")
+		requireNames(t, list(t, repo, models.BudgetQuery{
+			UserID:        userID,
+			StartsBefore:  at(2024, 2, 16),
+			EndsNotBefore: at(2024, 2, 15),
+		}), "feb")
+		requireNames(t, list(t, repo, models.BudgetQuery{
+			UserID:        userID,
+			StartsBefore:  at(2024, 3, 2),
+			EndsNotBefore: at(2024, 2, 1),
+		}), "jan", "feb", "mar")
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, NamePrefix: "ma"}), "mar")
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, NamePrefix: ".*"}))
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, Category: "food"}), "feb", "mar")
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, Category: "rent"}))
+	})
+
+	t.Run("ListOrder", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		ids := seed(t, repo, userID)
+
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, Descending: true}), "apr", "mar", "feb", "jan")
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, OrderBy: models.BudgetOrderName}), "apr", "feb", "jan", "mar")
+		// equal limits are ordered by ID
+		limitOrder := []string{"feb", "apr", "jan", "mar"}
+		if ids[3] < ids[1] {
+			limitOrder = []string{"apr", "feb", "jan", "mar"}
+		}
+		requireNames(t, list(t, repo, models.BudgetQuery{UserID: userID, OrderBy: models.BudgetOrderLimit}), limitOrder...)
+	})
+
+	t.Run("ListPages", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		seed(t, repo, userID)
+
+		for _, order := range []string{models.BudgetOrderStart, models.BudgetOrderName, models.BudgetOrderLimit} {
+			for _, descending := range []bool{false, true} {
+				query := models.BudgetQuery{UserID: userID, OrderBy: order, Descending: descending, Limit: 100}
+				want := list(t, repo, query)
+
+				got := []string{}
+				query.Limit = 3
+				for {
+					budgets, err := repo.ListBudgets(ctx, query)
+					requireNoError(t, err)
+					for _, budget := range budgets {
+						got = append(got, budget.Name)
+					}
+					if len(budgets) < query.Limit {
+						break
+					}
+					last := budgets[len(budgets)-1]
+					query.After = &models.BudgetCursor{ID: last.ID, Start: last.StartDate, Name: last.Name, Limit: last.Limit}
+				}
+				requireNames(t, got, want...)
+			}
+		}
+	})
+	t.Run("ListWithoutLimit", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		seed(t, repo, userID)
+
+		budgets, err := repo.ListBudgets(ctx, models.BudgetQuery{UserID: userID, OrderBy: models.BudgetOrderStart})
+		requireNoError(t, err)
+		names := make([]string, len(budgets))
+		for i, budget := range budgets {
+			names[i] = budget.Name
+		}
+		requireNames(t, names, "jan", "feb", "mar", "apr")
+	})
+}
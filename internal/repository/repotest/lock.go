@@ -0,0 +1,79 @@
+package repotest
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func RunLocker(t *testing.T, newLocker func(t *testing.T) service.Locker) {
+	ctx := context.Background()
+
+	t.Run("MutualExclusion", func(t *testing.T) {
+		locker := newLocker(t)
+		key := newUserID()
+		var holders, maxHolders atomic.Int32
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				unlock, err := locker.Lock(ctx, key)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				n := holders.Add(1)
+				for {
+					seen := maxHolders.Load()
+					if n <= seen || maxHolders.CompareAndSwap(seen, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				holders.Add(-1)
+				unlock()
+			}()
+		}
+		wg.Wait()
+		if got := maxHolders.Load(); got != 1 {
+			t.Fatalf("expected a single holder at a time, saw %d", got)
+		}
+	})
+
+	t.Run("KeysAreIndependent", func(t *testing.T) {
+		locker := newLocker(t)
+		unlock, err := locker.Lock(ctx, newUserID())
+		requireNoError(t, err)
+		defer unlock()
+
+		timeout, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		other, err := locker.Lock(timeout, newUserID())
+		requireNoError(t, err)
+		other()
+	})
+
+	t.Run("WaitHonoursContext", func(t *testing.T) {
+		locker := newLocker(t)
+		key := newUserID()
+		unlock, err := locker.Lock(ctx, key)
+		requireNoError(t, err)
+
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		if _, err := locker.Lock(timeout, key); err == nil {
+			t.Fatal("expected the second lock to time out")
+		}
+		unlock()
+		// releasing twice is harmless
+		unlock()
+		again, err := locker.Lock(ctx, key)
+		requireNoError(t, err)
+		again()
+	})
+}
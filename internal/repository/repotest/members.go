@@ -0,0 +1,105 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func runMembers(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository) {
+	ctx := context.Background()
+	shared := func(t *testing.T, repo service.BudgetRepository, ownerID, seriesID string, month int) string {
+		t.Helper()
+		id, err := repo.AddBudget(ctx, models.Budget{
+			UserID:    ownerID,
+			Name:      "household",
+			Limit:     500,
+			StartDate: date(2024, 1, month),
+			EndDate:   date(2024, 1, month+1),
+			Category:  []models.Category{},
+			SeriesID:  seriesID,
+		})
+		requireNoError(t, err)
+		return id
+	}
+
+	t.Run("MembersSeeSharedBudgets", func(t *testing.T) {
+		repo := newRepo(t)
+		ownerID, memberID, strangerID := newUserID(), newUserID(), newUserID()
+		seriesID := newUserID()
+		id := shared(t, repo, ownerID, seriesID, 1)
+		members := []models.Member{{UserID: memberID, Role: models.RoleViewer, AddedAt: date(2024, 1, 1)}}
+		requireNoError(t, repo.UpdateMembers(ctx, ownerID, id, members, 1))
+
+		budget, err := repo.GetBudget(ctx, memberID, id)
+		requireNoError(t, err)
+		if budget == nil || budget.UserID != ownerID || budget.Version != 2 || len(budget.Members) != 1 {
+			t.Fatalf("unexpected shared budget %+v", budget)
+		}
+		if member := budget.Members[0]; member.UserID != memberID || member.Role != models.RoleViewer || !member.AddedAt.Equal(date(2024, 1, 1)) {
+			t.Fatalf("unexpected member %+v", member)
+		}
+		budget, err = repo.GetBudget(ctx, strangerID, id)
+		requireNoError(t, err)
+		if budget != nil {
+			t.Fatalf("budget returned to a non-member: %+v", budget)
+		}
+
+		listed, err := repo.ListBudgets(ctx, models.BudgetQuery{UserID: memberID, OrderBy: models.BudgetOrderStart, Limit: 10})
+		requireNoError(t, err)
+		if !containsBudget(listed, id) {
+			t.Fatalf("shared budget is not listed for the member: %+v", listed)
+		}
+		series, err := repo.GetBudgetSeries(ctx, memberID, seriesID)
+		requireNoError(t, err)
+		if !containsBudget(series, id) {
+			t.Fatalf("shared budget is not in the member's series: %+v", series)
+		}
+		// overlap checks only consider the user's own budgets
+		own, err := repo.GetBudgetList(ctx, memberID)
+		requireNoError(t, err)
+		if containsBudget(own, id) {
+			t.Fatalf("shared budget listed as the member's own: %+v", own)
+		}
+	})
+
+	t.Run("OnlyTheOwnerUpdatesMembers", func(t *testing.T) {
+		repo := newRepo(t)
+		ownerID, memberID := newUserID(), newUserID()
+		id := shared(t, repo, ownerID, "", 1)
+		members := []models.Member{{UserID: memberID, Role: models.RoleEditor, AddedAt: date(2024, 1, 1)}}
+		requireNoError(t, repo.UpdateMembers(ctx, ownerID, id, members, 1))
+
+		requireKind(t, repo.UpdateMembers(ctx, memberID, id, nil, 2), apperrors.ErrNotFound)
+		requireKind(t, repo.UpdateMembers(ctx, ownerID, id, nil, 1), apperrors.ErrConflict)
+		requireKind(t, repo.UpdateMembers(ctx, ownerID, "not-an-id", nil, 2), apperrors.ErrInvalidArgument)
+		requireNoError(t, repo.UpdateMembers(ctx, ownerID, id, []models.Member{}, 2))
+
+		budget, err := repo.GetBudget(ctx, memberID, id)
+		requireNoError(t, err)
+		if budget != nil {
+			t.Fatalf("budget returned to a removed member: %+v", budget)
+		}
+	})
+
+	t.Run("ReturnedMembersAreACopy", func(t *testing.T) {
+		repo := newRepo(t)
+		ownerID, memberID := newUserID(), newUserID()
+		id := shared(t, repo, ownerID, "", 1)
+		members := []models.Member{{UserID: memberID, Role: models.RoleViewer}}
+		requireNoError(t, repo.UpdateMembers(ctx, ownerID, id, members, 1))
+		members[0].Role = models.RoleEditor
+
+		budget, err := repo.GetBudget(ctx, ownerID, id)
+		requireNoError(t, err)
+		budget.Members[0].Role = models.RoleEditor
+		budget, err = repo.GetBudget(ctx, ownerID, id)
+		requireNoError(t, err)
+		if budget.Members[0].Role != models.RoleViewer {
+			t.Fatal("mutating members must not change the stored ones")
+		}
+	})
+}
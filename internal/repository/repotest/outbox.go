@@ -0,0 +1,114 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func RunOutboxRepository(t *testing.T, newRepo func(t *testing.T) service.OutboxRepository) {
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	const lease = time.Minute
+
+	add := func(t *testing.T, repo service.OutboxRepository, userID string, types ...string) {
+		t.Helper()
+		events := make([]models.Event, len(types))
+		for i, eventType := range types {
+			events[i] = models.Event{
+				Type:        eventType,
+				UserID:      userID,
+				BudgetID:    "650000000000000000000001",
+				OccurredAt:  base,
+				Changes:     []models.FieldChange{{Field: "name", Before: "old", After: "new"}},
+				NextAttempt: base,
+			}
+		}
+		requireNoError(t, repo.AddEvents(ctx, events))
+	}
+	// claim keeps the claimed events of the user, other suites may share the
+	// outbox
+	claim := func(t *testing.T, repo service.OutboxRepository, userID string, now time.Time) []models.Event {
+		t.Helper()
+		events, err := repo.ClaimEvents(ctx, now, lease, 100)
+		requireNoError(t, err)
+		own := []models.Event{}
+		for _, event := range events {
+			if event.UserID == userID {
+				own = append(own, event)
+			}
+		}
+		return own
+	}
+
+	t.Run("ClaimInOrder", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		add(t, repo, userID, models.EventBudgetCreated, models.EventCategoryAdded)
+
+		events := claim(t, repo, userID, base)
+		if len(events) != 2 || events[0].Type != models.EventBudgetCreated || events[1].Type != models.EventCategoryAdded {
+			t.Fatalf("unexpected events %+v", events)
+		}
+		for _, event := range events {
+			requireHexID(t, event.ID)
+			if event.Attempts != 1 || len(event.Changes) != 1 {
+				t.Fatalf("unexpected event %+v", event)
+			}
+		}
+	})
+
+	t.Run("LeaseHidesClaimed", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		add(t, repo, userID, models.EventBudgetCreated)
+		if events := claim(t, repo, userID, base.Add(-time.Second)); len(events) != 0 {
+			t.Fatalf("expected no due events, got %+v", events)
+		}
+		if events := claim(t, repo, userID, base); len(events) != 1 {
+			t.Fatalf("expected 1 event, got %+v", events)
+		}
+		if events := claim(t, repo, userID, base.Add(lease/2)); len(events) != 0 {
+			t.Fatalf("expected leased event to be hidden, got %+v", events)
+		}
+		events := claim(t, repo, userID, base.Add(lease))
+		if len(events) != 1 || events[0].Attempts != 2 {
+			t.Fatalf("expected event to be claimed again after the lease, got %+v", events)
+		}
+	})
+
+	t.Run("DeleteEvent", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		add(t, repo, userID, models.EventBudgetDeleted)
+		events := claim(t, repo, userID, base)
+		requireNoError(t, repo.DeleteEvent(ctx, events[0].ID))
+		if events := claim(t, repo, userID, base.Add(time.Hour)); len(events) != 0 {
+			t.Fatalf("expected deleted event to be gone, got %+v", events)
+		}
+	})
+
+	t.Run("FailEvent", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		add(t, repo, userID, models.EventBudgetUpdated, models.EventLimitChanged)
+		events := claim(t, repo, userID, base)
+		next := base.Add(time.Hour)
+		requireNoError(t, repo.FailEvent(ctx, events[0].ID, next, "unavailable", false))
+		requireNoError(t, repo.FailEvent(ctx, events[1].ID, base, "unavailable", true))
+
+		if events := claim(t, repo, userID, next.Add(-time.Second)); len(events) != 0 {
+			t.Fatalf("expected no due events before the retry, got %+v", events)
+		}
+		events = claim(t, repo, userID, next)
+		if len(events) != 1 || events[0].Type != models.EventBudgetUpdated {
+			t.Fatalf("expected only the retried event, got %+v", events)
+		}
+		if events[0].Attempts != 2 || events[0].LastError != "unavailable" {
+			t.Fatalf("unexpected retried event %+v", events[0])
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func runRecurrence(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository) {
+	ctx := context.Background()
+	recurring := func(userID, seriesID string, start time.Time) models.Budget {
+		return models.Budget{
+			UserID:     userID,
+			Name:       "monthly",
+			Limit:      100,
+			StartDate:  start,
+			EndDate:    start.AddDate(0, 1, 0),
+			Category:   []models.Category{{Name: "food", Limit: 50}},
+			Recurrence: &models.Recurrence{Period: "month", Active: true},
+			SeriesID:   seriesID,
+		}
+	}
+
+	t.Run("AddBudgetAssignsCategoryIDs", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id, err := repo.AddBudget(ctx, recurring(userID, "series", date(2024, 1, 1)))
+		requireNoError(t, err)
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if len(budget.Category) != 1 {
+			t.Fatalf("unexpected categories %+v", budget.Category)
+		}
+		requireHexID(t, budget.Category[0].ID)
+		if budget.Recurrence == nil || !budget.Recurrence.Active || budget.Recurrence.Period != "month" || budget.SeriesID != "series" {
+			t.Fatalf("recurrence not stored: %+v", budget)
+		}
+	})
+
+	t.Run("RenewBudget", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		seriesID := newUserID()
+		first, err := repo.AddBudget(ctx, recurring(userID, seriesID, date(2024, 1, 1)))
+		requireNoError(t, err)
+		_, err = repo.AddBudget(ctx, recurring(userID, seriesID+"-other", date(2030, 1, 1)))
+		requireNoError(t, err)
+
+		due, err := repo.GetDueRecurringBudgets(ctx, date(2024, 2, 1))
+		requireNoError(t, err)
+		if !containsBudget(due, first) {
+			t.Fatalf("ended budget %s is not due: %+v", first, due)
+		}
+		due, err = repo.GetDueRecurringBudgets(ctx, date(2024, 1, 31))
+		requireNoError(t, err)
+		if containsBudget(due, first) {
+			t.Fatal("running budget must not be due")
+		}
+
+		second, err := repo.RenewBudget(ctx, first, recurring(userID, seriesID, date(2024, 2, 1)))
+		requireNoError(t, err)
+		requireHexID(t, second)
+		_, err = repo.RenewBudget(ctx, first, recurring(userID, seriesID, date(2024, 2, 1)))
+		requireKind(t, err, apperrors.ErrConflict)
+
+		due, err = repo.GetDueRecurringBudgets(ctx, date(2024, 2, 1))
+		requireNoError(t, err)
+		if containsBudget(due, first) {
+			t.Fatal("renewed budget must not be due again")
+		}
+		budget, err := repo.GetBudget(ctx, userID, first)
+		requireNoError(t, err)
+		if budget.NextID != second {
+			t.Fatalf("expected next id %s, got %s", second, budget.NextID)
+		}
+
+		series, err := repo.GetBudgetSeries(ctx, userID, seriesID)
+		requireNoError(t, err)
+		if len(series) != 2 || series[0].ID != first || series[1].ID != second {
+			t.Fatalf("unexpected series %+v", series)
+		}
+	})
+
+	t.Run("RolloverIsStored", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		budget := recurring(userID, "series", date(2024, 1, 1))
+		budget.Category[0].Carried = -1250
+		budget.Category[0].Rollover = &models.Rollover{Mode: models.RolloverBoth, Cap: 20}
+		id, err := repo.AddBudget(ctx, budget)
+		requireNoError(t, err)
+		err = repo.AddCategory(ctx, models.CreateCategory{
+			UserID:   userID,
+			BudgetID: id,
+			Name:     "drinks",
+			Limit:    10,
+			Rollover: &models.Rollover{Mode: models.RolloverSurplus},
+		}, 1)
+		requireNoError(t, err)
+
+		stored, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		food, drinks := stored.Category[0], stored.Category[1]
+		if food.Carried != -1250 || food.Rollover == nil || *food.Rollover != (models.Rollover{Mode: models.RolloverBoth, Cap: 20}) {
+			t.Fatalf("rollover not stored: %+v", food)
+		}
+		if drinks.Rollover == nil || drinks.Rollover.Mode != models.RolloverSurplus {
+			t.Fatalf("rollover not stored on added category: %+v", drinks)
+		}
+
+		drinks.Carried = 5
+		drinks.Rollover = &models.Rollover{Mode: models.RolloverDebt, Cap: 3}
+		requireNoError(t, repo.UpdateCategory(ctx, userID, id, drinks, stored.Version))
+		stored, err = repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if got := stored.Category[1]; got.Carried != 5 || got.Rollover == nil || *got.Rollover != *drinks.Rollover {
+			t.Fatalf("rollover not updated: %+v", got)
+		}
+	})
+
+	t.Run("StopRecurrence", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		seriesID := newUserID()
+		first, err := repo.AddBudget(ctx, recurring(userID, seriesID, date(2024, 1, 1)))
+		requireNoError(t, err)
+
+		requireKind(t, repo.StopRecurrence(ctx, newUserID(), seriesID), apperrors.ErrNotFound)
+		requireNoError(t, repo.StopRecurrence(ctx, userID, seriesID))
+		budget, err := repo.GetBudget(ctx, userID, first)
+		requireNoError(t, err)
+		if budget.Recurrence == nil || budget.Recurrence.Active {
+			t.Fatalf("recurrence still active: %+v", budget.Recurrence)
+		}
+		due, err := repo.GetDueRecurringBudgets(ctx, date(2025, 1, 1))
+		requireNoError(t, err)
+		if containsBudget(due, first) {
+			t.Fatal("stopped budget must not be due")
+		}
+	})
+}
+
+func containsBudget(budgets []models.Budget, id string) bool {
+	for _, budget := range budgets {
+		if budget.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+// Package repotest holds the conformance suite shared by every repository
+// implementation, so the in-memory and MongoDB repositories keep the same
+// semantics. Call the Run* functions from an implementation's tests:
+//
+//	repotest.RunBudgetRepository(t, func(t *testing.T) service.BudgetRepository {
+//		return memory.NewBudgetRepository()
+//	})
+//
+// RunLocker and RunConcurrentWrites cover the Locker that comes with a
+// storage; the latter stresses the service's invariants under concurrency.
+// RunTransactor checks that failed transactions are undone, which only the
+// MongoDB storage does.
+//
+// The suites only touch documents of freshly generated users, so the MongoDB
+// repositories can be run against a shared database. RunOutboxRepository
+// claims whatever events are due but only checks the ones it wrote.
+package repotest
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const missingID = "000000000000000000000000"
+
+var userSeq atomic.Int64
+
+// newUserID returns a user ID that is unique across the run, so suites can
+// share a database without seeing each other's documents.
+func newUserID() string {
+	return fmt.Sprintf("repotest-user-%d-%d", time.Now().UnixNano(), userSeq.Add(1))
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func requireKind(t *testing.T, err, kind error) {
+	t.Helper()
+	if !errors.Is(err, kind) {
+		t.Fatalf("expected %v error, got %v", kind, err)
+	}
+}
+
+func requireNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func requireHexID(t *testing.T, id string) {
+	t.Helper()
+	if len(id) != 24 {
+		t.Fatalf("expected 24 character hex id, got %q", id)
+	}
+	for _, c := range id {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			t.Fatalf("expected hex id, got %q", id)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+// slowReads widens the window between a service's check and its write, so
+// races surface even against fast repositories.
+type slowReads struct {
+	service.BudgetRepository
+}
+
+func (r slowReads) GetBudget(ctx context.Context, userID, budgetID string) (*models.Budget, error) {
+	budget, err := r.BudgetRepository.GetBudget(ctx, userID, budgetID)
+	time.Sleep(time.Millisecond)
+	return budget, err
+}
+
+func (r slowReads) GetBudgetList(ctx context.Context, userID string) ([]models.Budget, error) {
+	budgets, err := r.BudgetRepository.GetBudgetList(ctx, userID)
+	time.Sleep(time.Millisecond)
+	return budgets, err
+}
+
+type anyUser struct{}
+
+// discardAudit drops audit entries, the suite does not check them.
+type discardAudit struct{}
+
+func (discardAudit) AddAuditEntry(ctx context.Context, entry models.AuditEntry) error {
+	return nil
+}
+
+func (discardAudit) GetBudgetHistory(ctx context.Context, query models.HistoryQuery) ([]models.AuditEntry, error) {
+	return []models.AuditEntry{}, nil
+}
+
+// discardOutbox drops events, the suite does not check them.
+type discardOutbox struct{}
+
+func (discardOutbox) AddEvents(ctx context.Context, events []models.Event) error {
+	return nil
+}
+
+func (discardOutbox) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Event, error) {
+	return []models.Event{}, nil
+}
+
+func (discardOutbox) DeleteEvent(ctx context.Context, id string) error {
+	return nil
+}
+
+func (discardOutbox) FailEvent(ctx context.Context, id string, next time.Time, lastErr string, deadLetter bool) error {
+	return nil
+}
+
+// noTransaction runs functions directly, the invariants under test must hold
+// without transactions.
+type noTransaction struct{}
+
+func (noTransaction) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (anyUser) GetUser(ctx context.Context, id string) (string, string, error) {
+	return id, id, nil
+}
+
+// RunConcurrentWrites fires concurrent requests at a BudgetService backed by
+// the repository and locker under test, and checks that budgets of a user
+// never overlap and category names stay unique within a budget.
+func RunConcurrentWrites(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository, newLocker func(t *testing.T) service.Locker) {
+	ctx := context.Background()
+	const workers = 20
+
+	newService := func(t *testing.T) (*service.BudgetService, service.BudgetRepository) {
+		repo := newRepo(t)
+		return service.NewBudgetService(slowReads{repo}, nil, nil, discardAudit{}, discardOutbox{}, noTransaction{}, nil, newLocker(t), anyUser{}, nil, "USD"), repo
+	}
+	// run calls fn from all workers at once and returns their errors.
+	run := func(fn func(i int) error) []error {
+		errs := make([]error, workers)
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				errs[i] = fn(i)
+			}()
+		}
+		close(start)
+		wg.Wait()
+		return errs
+	}
+	countOK := func(t *testing.T, errs []error, allowed ...error) int {
+		t.Helper()
+		ok := 0
+		for _, err := range errs {
+			if err == nil {
+				ok++
+				continue
+			}
+			known := false
+			for _, kind := range allowed {
+				known = known || errors.Is(err, kind)
+			}
+			if !known {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		return ok
+	}
+
+	t.Run("ConcurrentOverlappingBudgets", func(t *testing.T) {
+		svc, repo := newService(t)
+		userID := newUserID()
+		errs := run(func(i int) error {
+			// every budget overlaps with its neighbours
+			_, err := svc.AddBudget(ctx, models.CreateBudget{
+				UserID:    userID,
+				Name:      fmt.Sprintf("budget %d", i),
+				Limit:     100,
+				StartDate: fmt.Sprintf("2024-01-%02d", i+1),
+				EndDate:   fmt.Sprintf("2024-01-%02d", i+5),
+			})
+			return err
+		})
+		created := countOK(t, errs, apperrors.ErrAlreadyExists)
+
+		budgets, err := repo.GetBudgetList(ctx, userID)
+		requireNoError(t, err)
+		if len(budgets) != created || created == 0 {
+			t.Fatalf("%d budgets reported as created, %d stored", created, len(budgets))
+		}
+		for i := range budgets {
+			for j := i + 1; j < len(budgets); j++ {
+				a, b := budgets[i], budgets[j]
+				if a.StartDate.Before(b.EndDate) && b.StartDate.Before(a.EndDate) {
+					t.Fatalf("overlapping budgets %s and %s were created", a.Name, b.Name)
+				}
+			}
+		}
+	})
+
+	t.Run("ConcurrentDuplicateCategories", func(t *testing.T) {
+		svc, repo := newService(t)
+		userID := newUserID()
+		id, err := svc.AddBudget(ctx, models.CreateBudget{UserID: userID, Name: "b", Limit: 100, StartDate: "2024-01-01", EndDate: "2024-02-01"})
+		requireNoError(t, err)
+
+		errs := run(func(i int) error {
+			_, _, err := svc.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: id, Name: "food", Limit: 10})
+			return err
+		})
+		added := countOK(t, errs, apperrors.ErrAlreadyExists, apperrors.ErrConflict)
+
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if added != 1 || len(budget.Category) != 1 {
+			t.Fatalf("expected exactly one food category, %d added and stored %+v", added, budget.Category)
+		}
+	})
+
+	t.Run("ConcurrentCategoriesAreNotLost", func(t *testing.T) {
+		svc, repo := newService(t)
+		userID := newUserID()
+		id, err := svc.AddBudget(ctx, models.CreateBudget{UserID: userID, Name: "b", Limit: 100, StartDate: "2024-01-01", EndDate: "2024-02-01"})
+		requireNoError(t, err)
+
+		errs := run(func(i int) error {
+			_, _, err := svc.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: id, Name: fmt.Sprintf("c%d", i), Limit: 10})
+			return err
+		})
+		added := countOK(t, errs, apperrors.ErrConflict)
+
+		budget, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		if added == 0 || len(budget.Category) != added {
+			t.Fatalf("%d categories reported as added, %d stored", added, len(budget.Category))
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+// RunTemplateRepository expects the repository to keep template names
+// unique per user, for MongoDB that takes the indexes of EnsureIndexes.
+func RunTemplateRepository(t *testing.T, newRepo func(t *testing.T) service.TemplateRepository) {
+	ctx := context.Background()
+	newTemplate := func(userID, name string) models.Template {
+		return models.Template{
+			UserID:     userID,
+			Name:       name,
+			Limit:      1000,
+			Currency:   "EUR",
+			Allocation: models.AllocationWarn,
+			Categories: []models.TemplateCategory{
+				{Name: "rent", Limit: 600},
+				{Name: "food", Percent: 2550, Rollover: &models.Rollover{Mode: models.RolloverSurplus, Cap: 50}},
+			},
+			CreatedAt: date(2024, 1, 1),
+			UpdatedAt: date(2024, 1, 1),
+		}
+	}
+
+	t.Run("AddAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id, err := repo.AddTemplate(ctx, newTemplate(userID, "monthly"))
+		requireNoError(t, err)
+		requireHexID(t, id)
+
+		template, err := repo.GetTemplate(ctx, userID, id)
+		requireNoError(t, err)
+		if template == nil || template.ID != id || template.Name != "monthly" || template.Limit != 1000 || template.Currency != "EUR" ||
+			template.Allocation != models.AllocationWarn || !template.CreatedAt.Equal(date(2024, 1, 1)) {
+			t.Fatalf("unexpected template %+v", template)
+		}
+		if len(template.Categories) != 2 || template.Categories[0].Limit != 600 || template.Categories[1].Percent != 2550 ||
+			template.Categories[1].Rollover == nil || template.Categories[1].Rollover.Cap != 50 {
+			t.Fatalf("unexpected categories %+v", template.Categories)
+		}
+
+		template, err = repo.GetTemplate(ctx, newUserID(), id)
+		requireNoError(t, err)
+		if template != nil {
+			t.Fatalf("template of another user returned: %+v", template)
+		}
+		template, err = repo.GetTemplate(ctx, userID, missingID)
+		requireNoError(t, err)
+		if template != nil {
+			t.Fatalf("expected nil template, got %+v", template)
+		}
+		_, err = repo.GetTemplate(ctx, userID, "not-an-id")
+		requireKind(t, err, apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("NamesAreUniquePerUser", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		_, err := repo.AddTemplate(ctx, newTemplate(userID, "monthly"))
+		requireNoError(t, err)
+		_, err = repo.AddTemplate(ctx, newTemplate(userID, "monthly"))
+		requireKind(t, err, apperrors.ErrAlreadyExists)
+		_, err = repo.AddTemplate(ctx, newTemplate(newUserID(), "monthly"))
+		requireNoError(t, err)
+
+		other, err := repo.AddTemplate(ctx, newTemplate(userID, "weekly"))
+		requireNoError(t, err)
+		renamed := newTemplate(userID, "monthly")
+		renamed.ID = other
+		requireKind(t, repo.UpdateTemplate(ctx, renamed), apperrors.ErrAlreadyExists)
+	})
+
+	t.Run("ListSortedByName", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		for _, name := range []string{"weekly", "holiday", "monthly"} {
+			_, err := repo.AddTemplate(ctx, newTemplate(userID, name))
+			requireNoError(t, err)
+		}
+		_, err := repo.AddTemplate(ctx, newTemplate(newUserID(), "other"))
+		requireNoError(t, err)
+
+		templates, err := repo.ListTemplates(ctx, userID)
+		requireNoError(t, err)
+		if len(templates) != 3 || templates[0].Name != "holiday" || templates[1].Name != "monthly" || templates[2].Name != "weekly" {
+			t.Fatalf("unexpected templates %+v", templates)
+		}
+		templates, err = repo.ListTemplates(ctx, newUserID())
+		requireNoError(t, err)
+		if templates == nil || len(templates) != 0 {
+			t.Fatalf("expected empty non-nil list, got %#v", templates)
+		}
+	})
+
+	t.Run("UpdateReplacesFields", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id, err := repo.AddTemplate(ctx, newTemplate(userID, "monthly"))
+		requireNoError(t, err)
+
+		update := models.Template{
+			ID:         id,
+			UserID:     userID,
+			Name:       "household",
+			Limit:      2000,
+			Currency:   "USD",
+			Allocation: models.AllocationStrict,
+			Categories: []models.TemplateCategory{{Name: "rent", Percent: 5000}},
+			UpdatedAt:  date(2024, 2, 1),
+		}
+		requireNoError(t, repo.UpdateTemplate(ctx, update))
+		template, err := repo.GetTemplate(ctx, userID, id)
+		requireNoError(t, err)
+		if template.Name != "household" || template.Limit != 2000 || template.Currency != "USD" || template.Allocation != models.AllocationStrict ||
+			len(template.Categories) != 1 || template.Categories[0].Percent != 5000 {
+			t.Fatalf("update not applied: %+v", template)
+		}
+		if !template.CreatedAt.Equal(date(2024, 1, 1)) || !template.UpdatedAt.Equal(date(2024, 2, 1)) {
+			t.Fatalf("unexpected timestamps %v, %v", template.CreatedAt, template.UpdatedAt)
+		}
+
+		update.UserID = newUserID()
+		requireKind(t, repo.UpdateTemplate(ctx, update), apperrors.ErrNotFound)
+		update.ID = missingID
+		requireKind(t, repo.UpdateTemplate(ctx, update), apperrors.ErrNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id, err := repo.AddTemplate(ctx, newTemplate(userID, "monthly"))
+		requireNoError(t, err)
+
+		requireKind(t, repo.DeleteTemplate(ctx, newUserID(), id), apperrors.ErrNotFound)
+		requireNoError(t, repo.DeleteTemplate(ctx, userID, id))
+		requireKind(t, repo.DeleteTemplate(ctx, userID, id), apperrors.ErrNotFound)
+		requireKind(t, repo.DeleteTemplate(ctx, userID, "bad"), apperrors.ErrInvalidArgument)
+	})
+
+	t.Run("ReturnedTemplateIsACopy", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		id, err := repo.AddTemplate(ctx, newTemplate(userID, "monthly"))
+		requireNoError(t, err)
+
+		template, err := repo.GetTemplate(ctx, userID, id)
+		requireNoError(t, err)
+		template.Categories[0].Name = "changed"
+		template.Categories[1].Rollover.Cap = 1
+		template, err = repo.GetTemplate(ctx, userID, id)
+		requireNoError(t, err)
+		if template.Categories[0].Name == "changed" || template.Categories[1].Rollover.Cap == 1 {
+			t.Fatal("mutating a returned template must not change the stored one")
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+// Storage is the set of repositories that share a Transactor.
+type Storage struct {
+	Budgets  service.BudgetRepository
+	Expenses service.ExpenseRepository
+	Audit    service.AuditRepository
+	Outbox   service.OutboxRepository
+	Tx       service.Transactor
+}
+
+var errExpenseDelete = errors.New("expense delete failed")
+
+// failingExpenses fails every DeleteBudgetExpenses call.
+type failingExpenses struct {
+	service.ExpenseRepository
+}
+
+func (failingExpenses) DeleteBudgetExpenses(ctx context.Context, budgetIDs []string) error {
+	return errExpenseDelete
+}
+
+// RunTransactor checks that the writes of a failed transaction are undone,
+// through the service methods that rely on it. The in-memory storage has no
+// transactions and is not expected to pass.
+func RunTransactor(t *testing.T, newStorage func(t *testing.T) Storage) {
+	ctx := context.Background()
+
+	t.Run("PurgeDeletedWithFailingExpenseDelete", func(t *testing.T) {
+		storage := newStorage(t)
+		userID := newUserID()
+		budgetID, err := storage.Budgets.AddBudget(ctx, models.Budget{
+			UserID:    userID,
+			Name:      "purged",
+			Limit:     500,
+			StartDate: date(2024, 1, 1),
+			EndDate:   date(2024, 2, 1),
+			Category:  []models.Category{},
+		})
+		requireNoError(t, err)
+		budget, err := storage.Budgets.GetBudget(ctx, userID, budgetID)
+		requireNoError(t, err)
+		expenseID, err := storage.Expenses.AddExpense(ctx, models.Expense{
+			UserID: userID, BudgetID: budget.ID, CategoryID: "food", Amount: 1, Date: date(2024, 1, 1),
+		})
+		requireNoError(t, err)
+		requireNoError(t, storage.Budgets.DeleteBudget(ctx, userID, budget.ID, budget.Version))
+
+		failing := service.NewBudgetService(storage.Budgets, failingExpenses{storage.Expenses}, nil,
+			storage.Audit, storage.Outbox, storage.Tx, nil, nil, nil, nil, "USD")
+		err = failing.PurgeDeleted(ctx, time.Now().Add(time.Minute))
+		if !errors.Is(err, errExpenseDelete) {
+			t.Fatalf("expected the expense delete to fail the purge, got %v", err)
+		}
+		deleted, err := storage.Budgets.GetDeletedBudgets(ctx, userID)
+		requireNoError(t, err)
+		if !containsBudget(deleted, budget.ID) {
+			t.Fatal("budget purged although its expenses were not deleted")
+		}
+
+		purger := service.NewBudgetService(storage.Budgets, storage.Expenses, nil,
+			storage.Audit, storage.Outbox, storage.Tx, nil, nil, nil, nil, "USD")
+		requireNoError(t, purger.PurgeDeleted(ctx, time.Now().Add(time.Minute)))
+		deleted, err = storage.Budgets.GetDeletedBudgets(ctx, userID)
+		requireNoError(t, err)
+		if containsBudget(deleted, budget.ID) {
+			t.Fatal("budget not purged")
+		}
+		expense, err := storage.Expenses.GetExpense(ctx, expenseID)
+		requireNoError(t, err)
+		if expense != nil {
+			t.Fatal("expense of the purged budget was kept")
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+func runTrash(t *testing.T, newRepo func(t *testing.T) service.BudgetRepository) {
+	ctx := context.Background()
+	addBudget := func(t *testing.T, repo service.BudgetRepository, userID string, categories ...string) models.Budget {
+		t.Helper()
+		budget := models.Budget{
+			UserID:    userID,
+			Name:      "trash",
+			Limit:     500,
+			StartDate: date(2024, 1, 1),
+			EndDate:   date(2024, 2, 1),
+			Category:  []models.Category{},
+		}
+		for _, name := range categories {
+			budget.Category = append(budget.Category, models.Category{Name: name, Limit: 10})
+		}
+		id, err := repo.AddBudget(ctx, budget)
+		requireNoError(t, err)
+		stored, err := repo.GetBudget(ctx, userID, id)
+		requireNoError(t, err)
+		return *stored
+	}
+
+	t.Run("DeletedBudgetIsHidden", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		budget := addBudget(t, repo, userID, "food")
+		requireNoError(t, repo.DeleteBudget(ctx, userID, budget.ID, budget.Version))
+
+		budgets, err := repo.GetBudgetList(ctx, userID)
+		requireNoError(t, err)
+		if len(budgets) != 0 {
+			t.Fatalf("deleted budget is listed: %+v", budgets)
+		}
+		listed, err := repo.ListBudgets(ctx, models.BudgetQuery{UserID: userID, OrderBy: models.BudgetOrderStart, Limit: 10})
+		requireNoError(t, err)
+		if len(listed) != 0 {
+			t.Fatalf("deleted budget is listed: %+v", listed)
+		}
+		err = repo.UpdateBudget(ctx, models.Budget{ID: budget.ID, UserID: userID, Version: budget.Version + 1})
+		requireKind(t, err, apperrors.ErrNotFound)
+
+		deleted, err := repo.GetDeletedBudgets(ctx, userID)
+		requireNoError(t, err)
+		if len(deleted) != 1 || deleted[0].ID != budget.ID || deleted[0].DeletedAt == nil {
+			t.Fatalf("unexpected deleted budgets %+v", deleted)
+		}
+		if len(deleted[0].Category) != 1 {
+			t.Fatalf("expected the categories of the deleted budget, got %+v", deleted[0].Category)
+		}
+	})
+
+	t.Run("RestoreBudget", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		budget := addBudget(t, repo, userID)
+		requireNoError(t, repo.DeleteBudget(ctx, userID, budget.ID, budget.Version))
+
+		requireKind(t, repo.RestoreBudget(ctx, newUserID(), budget.ID, budget.Version+1), apperrors.ErrNotFound)
+		requireKind(t, repo.RestoreBudget(ctx, userID, budget.ID, budget.Version), apperrors.ErrConflict)
+		requireNoError(t, repo.RestoreBudget(ctx, userID, budget.ID, budget.Version+1))
+		restored, err := repo.GetBudget(ctx, userID, budget.ID)
+		requireNoError(t, err)
+		if restored == nil || restored.DeletedAt != nil {
+			t.Fatalf("expected restored budget, got %+v", restored)
+		}
+		if restored.Version != budget.Version+2 {
+			t.Fatalf("expected version %d, got %d", budget.Version+2, restored.Version)
+		}
+		requireKind(t, repo.RestoreBudget(ctx, userID, budget.ID, restored.Version), apperrors.ErrNotFound)
+	})
+
+	t.Run("DeletedCategoryIsHidden", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		budget := addBudget(t, repo, userID, "food", "rent")
+		food := budget.Category[0]
+		requireNoError(t, repo.DeleteCategory(ctx, userID, budget.ID, food.ID, budget.Version))
+
+		stored, err := repo.GetBudget(ctx, userID, budget.ID)
+		requireNoError(t, err)
+		if len(stored.Category) != 1 || stored.Category[0].Name != "rent" {
+			t.Fatalf("unexpected categories %+v", stored.Category)
+		}
+		listed, err := repo.ListBudgets(ctx, models.BudgetQuery{UserID: userID, Category: "food", OrderBy: models.BudgetOrderStart, Limit: 10})
+		requireNoError(t, err)
+		if len(listed) != 0 {
+			t.Fatalf("budget listed by a deleted category: %+v", listed)
+		}
+		err = repo.UpdateCategory(ctx, userID, budget.ID, food, stored.Version)
+		requireKind(t, err, apperrors.ErrNotFound)
+
+		deleted, err := repo.GetDeletedCategories(ctx, userID)
+		requireNoError(t, err)
+		if len(deleted) != 1 || deleted[0].BudgetID != budget.ID || deleted[0].Category.ID != food.ID || deleted[0].Category.DeletedAt == nil {
+			t.Fatalf("unexpected deleted categories %+v", deleted)
+		}
+
+		// the name of a deleted category can be reused
+		err = repo.AddCategory(ctx, models.CreateCategory{UserID: userID, BudgetID: budget.ID, Name: "food", Limit: 1}, stored.Version)
+		requireNoError(t, err)
+	})
+
+	t.Run("RestoreCategory", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		budget := addBudget(t, repo, userID, "food")
+		food := budget.Category[0]
+		requireNoError(t, repo.DeleteCategory(ctx, userID, budget.ID, food.ID, budget.Version))
+
+		err := repo.RestoreCategory(ctx, userID, budget.ID, food.ID, budget.Version)
+		requireKind(t, err, apperrors.ErrConflict)
+		requireNoError(t, repo.RestoreCategory(ctx, userID, budget.ID, food.ID, budget.Version+1))
+		stored, err := repo.GetBudget(ctx, userID, budget.ID)
+		requireNoError(t, err)
+		if len(stored.Category) != 1 || stored.Category[0].ID != food.ID || stored.Category[0].DeletedAt != nil {
+			t.Fatalf("unexpected categories after restore %+v", stored.Category)
+		}
+		err = repo.RestoreCategory(ctx, userID, budget.ID, food.ID, stored.Version)
+		requireKind(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("PurgeDeleted", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := newUserID()
+		deleted := addBudget(t, repo, userID)
+		requireNoError(t, repo.DeleteBudget(ctx, userID, deleted.ID, deleted.Version))
+		kept := addBudget(t, repo, userID, "food", "rent")
+		requireNoError(t, repo.DeleteCategory(ctx, userID, kept.ID, kept.Category[0].ID, kept.Version))
+
+		purged, err := repo.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+		requireNoError(t, err)
+		if containsBudget(purged, deleted.ID) {
+			t.Fatal("budget purged before the retention passed")
+		}
+
+		purged, err = repo.PurgeDeleted(ctx, time.Now().Add(time.Minute))
+		requireNoError(t, err)
+		if !containsBudget(purged, deleted.ID) || containsBudget(purged, kept.ID) {
+			t.Fatalf("unexpected purged budgets %+v", purged)
+		}
+		budgets, err := repo.GetDeletedBudgets(ctx, userID)
+		requireNoError(t, err)
+		if len(budgets) != 0 {
+			t.Fatalf("purged budget still in the trash: %+v", budgets)
+		}
+		categories, err := repo.GetDeletedCategories(ctx, userID)
+		requireNoError(t, err)
+		if len(categories) != 0 {
+			t.Fatalf("purged category still in the trash: %+v", categories)
+		}
+		stored, err := repo.GetBudget(ctx, userID, kept.ID)
+		requireNoError(t, err)
+		if len(stored.Category) != 1 || stored.Category[0].Name != "rent" {
+			t.Fatalf("unexpected categories after purge %+v", stored.Category)
+		}
+	})
+}
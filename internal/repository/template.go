@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TemplateRepo relies on the unique index created by EnsureIndexes to keep
+// template names unique per user.
+type TemplateRepo struct {
+	collection *mongo.Collection
+}
+
+func NewTemplateRepository(db *mongo.Database, collection string) *TemplateRepo {
+	return &TemplateRepo{
+		collection: db.Collection(collection),
+	}
+}
+
+func (r *TemplateRepo) AddTemplate(ctx context.Context, template models.Template) (string, error) {
+	result, err := r.collection.InsertOne(ctx, template)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", apperrors.AlreadyExists("template with name %s already exists", template.Name)
+		}
+		return "", err
+	}
+	return result.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *TemplateRepo) GetTemplate(ctx context.Context, userID, templateID string) (*models.Template, error) {
+	oid, err := convertToObjectIDs(templateID)
+	if err != nil {
+		return nil, err
+	}
+	var template models.Template
+	err = r.collection.FindOne(ctx, bson.M{"_id": oid[0], "user_id": userID}).Decode(&template)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *TemplateRepo) ListTemplates(ctx context.Context, userID string) ([]models.Template, error) {
+	templates := []models.Template{}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &templates)
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (r *TemplateRepo) UpdateTemplate(ctx context.Context, template models.Template) error {
+	oid, err := convertToObjectIDs(template.ID)
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$set": bson.M{
+		"name":       template.Name,
+		"limit":      template.Limit,
+		"currency":   template.Currency,
+		"allocation": template.Allocation,
+		"categories": template.Categories,
+		"updated_at": template.UpdatedAt,
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid[0], "user_id": template.UserID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return apperrors.AlreadyExists("template with name %s already exists", template.Name)
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return apperrors.NotFound("template is not found")
+	}
+	return nil
+}
+
+func (r *TemplateRepo) DeleteTemplate(ctx context.Context, userID, templateID string) error {
+	oid, err := convertToObjectIDs(templateID)
+	if err != nil {
+		return err
+	}
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": oid[0], "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return apperrors.NotFound("template is not found")
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *BudgetRepo) GetDeletedBudgets(ctx context.Context, userID string) ([]models.Budget, error) {
+	budgets := []models.Budget{}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID, "deleted_at": bson.M{"$ne": nil}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &budgets)
+	if err != nil {
+		return nil, err
+	}
+	return withActiveCategories(budgets), nil
+}
+
+func (r *BudgetRepo) GetDeletedCategories(ctx context.Context, userID string) ([]models.DeletedCategory, error) {
+	filter := bson.M{
+		"user_id":               userID,
+		"deleted_at":            nil,
+		"categories.deleted_at": bson.M{"$ne": nil},
+	}
+	budgets := []models.Budget{}
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &budgets)
+	if err != nil {
+		return nil, err
+	}
+	categories := []models.DeletedCategory{}
+	for _, budget := range budgets {
+		for _, categ := range budget.Category {
+			if categ.DeletedAt != nil {
+				categories = append(categories, models.DeletedCategory{BudgetID: budget.ID, Category: categ})
+			}
+		}
+	}
+	return categories, nil
+}
+
+func (r *BudgetRepo) RestoreBudget(ctx context.Context, userID, budgetID string, version int64) error {
+	oid, err := convertToObjectIDs(budgetID)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"_id": oid[0], "user_id": userID, "version": versionFilter(version), "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$unset": bson.M{"deleted_at": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		var budget models.Budget
+		err := r.collection.FindOne(ctx, bson.M{"_id": oid[0], "user_id": userID, "deleted_at": bson.M{"$ne": nil}}).Decode(&budget)
+		if err == mongo.ErrNoDocuments {
+			return apperrors.NotFound("deleted budget is not found")
+		}
+		if err != nil {
+			return err
+		}
+		return apperrors.Conflict("budget was modified concurrently, current version is %d", budget.Version)
+	}
+	return nil
+}
+
+func (r *BudgetRepo) RestoreCategory(ctx context.Context, userID, budgetID, catID string, version int64) error {
+	oid, err := convertToObjectIDs(budgetID)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{
+		"_id":        oid[0],
+		"user_id":    userID,
+		"version":    versionFilter(version),
+		"deleted_at": nil,
+		"categories": bson.M{"$elemMatch": bson.M{"category_id": catID, "deleted_at": bson.M{"$ne": nil}}},
+	}
+	update := bson.M{
+		"$unset": bson.M{"categories.$.deleted_at": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		if err := r.checkVersion(ctx, oid[0], userID, version); err != nil {
+			return err
+		}
+		return apperrors.NotFound("deleted category is not found")
+	}
+	return nil
+}
+
+// PurgeDeleted removes the budgets and categories deleted before the given
+// time for good and returns the purged budgets.
+func (r *BudgetRepo) PurgeDeleted(ctx context.Context, before time.Time) ([]models.Budget, error) {
+	budgets := []models.Budget{}
+	cursor, err := r.collection.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": before}})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &budgets); err != nil {
+		return nil, err
+	}
+	if len(budgets) > 0 {
+		oids := make(bson.A, 0, len(budgets))
+		for _, budget := range budgets {
+			oid, err := primitive.ObjectIDFromHex(budget.ID)
+			if err != nil {
+				return nil, err
+			}
+			oids = append(oids, oid)
+		}
+		if _, err := r.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": oids}}); err != nil {
+			return nil, err
+		}
+	}
+
+	expired := bson.M{"deleted_at": bson.M{"$lt": before}}
+	_, err = r.collection.UpdateMany(ctx, bson.M{"categories": bson.M{"$elemMatch": expired}}, bson.M{
+		"$pull": bson.M{"categories": expired},
+		"$inc":  bson.M{"version": 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withActiveCategories(budgets), nil
+}
+
+func (r *ExpenseRepo) DeleteBudgetExpenses(ctx context.Context, budgetIDs []string) error {
+	if len(budgetIDs) == 0 {
+		return nil
+	}
+	_, err := r.collection.DeleteMany(ctx, bson.M{"budget_id": bson.M{"$in": budgetIDs}})
+	return err
+}
@@ -0,0 +1,25 @@
+// Package requestinfo carries details about the RPC being served, such as
+// its request ID, through a context down to the service.
+package requestinfo
+
+import "context"
+
+type Info struct {
+	// RPC is the method name, e.g. UpdateBudget.
+	RPC       string
+	RequestID string
+	// Actor identifies the caller, it is empty when the caller is unknown.
+	Actor string
+}
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the info stored in ctx, or the zero Info.
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	return info
+}
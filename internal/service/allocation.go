@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func validateAllocation(policy string) error {
+	switch policy {
+	case "", models.AllocationUnrestricted, models.AllocationWarn, models.AllocationStrict:
+		return nil
+	default:
+		return apperrors.InvalidArgument("invalid allocation policy %q, expected one of: unrestricted, warn, strict", policy)
+	}
+}
+
+// allocated sums the category limits, leaving out the category with the
+// given ID. Categories of budgets that are not stored yet have no IDs, an
+// empty exceptID leaves out none.
+func allocated(categories []models.Category, exceptID string) models.Money {
+	var total models.Money
+	for _, categ := range categories {
+		if exceptID == "" || categ.ID != exceptID {
+			total += categ.Limit
+		}
+	}
+	return total
+}
+
+// checkCategoryAllocation applies the budget's policy to a category limit
+// that is added to, or replaces, the category with ID categoryID.
+func checkCategoryAllocation(budget *models.Budget, categoryID string, limit models.Money) ([]string, error) {
+	unallocated := budget.Limit - allocated(budget.Category, categoryID)
+	if limit <= unallocated {
+		return nil, nil
+	}
+	switch budget.Allocation {
+	case models.AllocationStrict:
+		return nil, apperrors.FailedPrecondition("category limit %s exceeds the unallocated %s of the budget", limit, unallocated)
+	case models.AllocationWarn:
+		return []string{fmt.Sprintf("category limits exceed the budget limit %s by %s", budget.Limit, limit-unallocated)}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// checkBudgetAllocation applies policy to a budget limit change, or to a
+// policy change, of a budget with the given categories.
+func checkBudgetAllocation(policy string, limit models.Money, categories []models.Category) ([]string, error) {
+	total := allocated(categories, "")
+	if total <= limit {
+		return nil, nil
+	}
+	switch policy {
+	case models.AllocationStrict:
+		return nil, apperrors.FailedPrecondition("categories are allocated %s, %s over the budget limit %s", total, total-limit, limit)
+	case models.AllocationWarn:
+		return []string{fmt.Sprintf("category limits exceed the budget limit %s by %s", limit, total-limit)}, nil
+	default:
+		return nil, nil
+	}
+}
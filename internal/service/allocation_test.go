@@ -0,0 +1,35 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func TestCheckBudgetAllocation(t *testing.T) {
+	categories := []models.Category{{Name: "food", Limit: 6000}, {Name: "rent", Limit: 5000}}
+	tests := []struct {
+		name     string
+		policy   string
+		limit    models.Money
+		warnings []string
+		err      string
+	}{
+		{"within the limit", models.AllocationStrict, 11000, nil, ""},
+		{"strict", models.AllocationStrict, 10000, nil, "categories are allocated 110.00, 10.00 over the budget limit 100.00"},
+		{"warn", models.AllocationWarn, 10000, []string{"category limits exceed the budget limit 100.00 by 10.00"}, ""},
+		{"unrestricted", "", 10000, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := checkBudgetAllocation(tt.policy, tt.limit, categories)
+			if err != nil && err.Error() != tt.err || err == nil && tt.err != "" {
+				t.Fatalf("error = %v, want %q", err, tt.err)
+			}
+			if !slices.Equal(warnings, tt.warnings) {
+				t.Fatalf("warnings %q, want %q", warnings, tt.warnings)
+			}
+		})
+	}
+}
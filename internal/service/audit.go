@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/requestinfo"
+)
+
+type AuditRepository interface {
+	AddAuditEntry(ctx context.Context, entry models.AuditEntry) error
+	GetBudgetHistory(ctx context.Context, query models.HistoryQuery) ([]models.AuditEntry, error)
+}
+
+// systemActor is the actor of changes made by background jobs.
+const systemActor = "system"
+
+// audit records the change of a budget from before to after, either of which
+// is nil when the budget was created or removed. It is called inside the
+// transaction of the change, so a failed write undoes the change.
+func (s *BudgetService) audit(ctx context.Context, actor, operation string, before, after *models.Budget) error {
+	budget := after
+	if budget == nil {
+		budget = before
+	}
+	if budget == nil {
+		return nil
+	}
+	return s.addAuditEntry(ctx, actor, operation, budget.UserID, budget.ID, diffBudgets(before, after))
+}
+
+// auditExpense records an expense being added to or removed from its budget,
+// in the history of the budget.
+func (s *BudgetService) auditExpense(ctx context.Context, actor, operation string, before, after *models.Expense) error {
+	expense := after
+	if expense == nil {
+		expense = before
+	}
+	changes := []models.FieldChange{}
+	from, to := expenseFields(before), expenseFields(after)
+	for _, field := range expenseFieldNames {
+		if from[field] != to[field] {
+			changes = append(changes, models.FieldChange{
+				Field:  fmt.Sprintf("expenses[%s].%s", expense.ID, field),
+				Before: from[field],
+				After:  to[field],
+			})
+		}
+	}
+	return s.addAuditEntry(ctx, actor, operation, expense.UserID, expense.BudgetID, changes)
+}
+
+// addAuditEntry writes an entry to the history of a budget. The actor is used
+// unless the request carries an identified caller, and operation unless it
+// names its RPC.
+func (s *BudgetService) addAuditEntry(ctx context.Context, actor, operation, userID, budgetID string, changes []models.FieldChange) error {
+	info := requestinfo.FromContext(ctx)
+	if info.Actor != "" {
+		actor = info.Actor
+	}
+	if info.RPC != "" {
+		operation = info.RPC
+	}
+	entry := models.AuditEntry{
+		UserID:    userID,
+		BudgetID:  budgetID,
+		Actor:     actor,
+		RPC:       operation,
+		RequestID: info.RequestID,
+		// MongoDB keeps milliseconds, which page tokens have to agree with
+		Time:    time.Now().UTC().Truncate(time.Millisecond),
+		Changes: changes,
+	}
+	return s.Audit.AddAuditEntry(ctx, entry)
+}
+
+func diffBudgets(before, after *models.Budget) []models.FieldChange {
+	changes := []models.FieldChange{}
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, models.FieldChange{Field: field, Before: from, After: to})
+		}
+	}
+	from, to := budgetFields(before), budgetFields(after)
+	for _, field := range budgetFieldNames {
+		add(field, from[field], to[field])
+	}
+
+	oldCategories, newCategories := map[string]models.Category{}, map[string]models.Category{}
+	ids := []string{}
+	if before != nil {
+		for _, categ := range before.Category {
+			oldCategories[categ.ID] = categ
+			ids = append(ids, categ.ID)
+		}
+	}
+	if after != nil {
+		for _, categ := range after.Category {
+			newCategories[categ.ID] = categ
+			if _, ok := oldCategories[categ.ID]; !ok {
+				ids = append(ids, categ.ID)
+			}
+		}
+	}
+	for _, id := range ids {
+		var from, to map[string]string
+		if categ, ok := oldCategories[id]; ok {
+			from = categoryFields(categ)
+		}
+		if categ, ok := newCategories[id]; ok {
+			to = categoryFields(categ)
+		}
+		for _, field := range categoryFieldNames {
+			add(fmt.Sprintf("categories[%s].%s", id, field), from[field], to[field])
+		}
+	}
+
+	oldRoles, newRoles := memberRoles(before), memberRoles(after)
+	for _, id := range memberIDs(before, after) {
+		add(fmt.Sprintf("members[%s].role", id), oldRoles[id], newRoles[id])
+	}
+	return changes
+}
+
+func memberRoles(budget *models.Budget) map[string]string {
+	roles := map[string]string{}
+	if budget != nil {
+		for _, member := range budget.Members {
+			roles[member.UserID] = member.Role
+		}
+	}
+	return roles
+}
+
+var budgetFieldNames = []string{
+	"name", "limit", "currency", "allocation", "start", "end",
+	"series_id", "recurrence.period", "recurrence.active", "deleted_at", "version",
+}
+
+func budgetFields(budget *models.Budget) map[string]string {
+	if budget == nil {
+		return nil
+	}
+	fields := map[string]string{
+		"name":       budget.Name,
+		"limit":      budget.Limit.String(),
+		"currency":   budget.Currency,
+		"allocation": budget.Allocation,
+		"start":      budget.StartDate.Format(Dateformat),
+		"end":        budget.EndDate.Format(Dateformat),
+		"series_id":  budget.SeriesID,
+		"deleted_at": formatTimestamp(budget.DeletedAt),
+		"version":    strconv.FormatInt(budget.Version, 10),
+	}
+	if budget.Recurrence != nil {
+		fields["recurrence.period"] = budget.Recurrence.Period
+		fields["recurrence.active"] = strconv.FormatBool(budget.Recurrence.Active)
+	}
+	return fields
+}
+
+var categoryFieldNames = []string{"name", "limit", "carried", "rollover.mode", "rollover.cap", "deleted_at"}
+
+func categoryFields(categ models.Category) map[string]string {
+	fields := map[string]string{
+		"name":       categ.Name,
+		"limit":      categ.Limit.String(),
+		"carried":    categ.Carried.String(),
+		"deleted_at": formatTimestamp(categ.DeletedAt),
+	}
+	if categ.Rollover != nil {
+		fields["rollover.mode"] = categ.Rollover.Mode
+		fields["rollover.cap"] = categ.Rollover.Cap.String()
+	}
+	return fields
+}
+
+var expenseFieldNames = []string{"category", "amount", "currency", "date", "note", "created_by"}
+
+func expenseFields(expense *models.Expense) map[string]string {
+	if expense == nil {
+		return nil
+	}
+	return map[string]string{
+		"category":   expense.CategoryID,
+		"amount":     expense.Amount.String(),
+		"currency":   expense.Currency,
+		"date":       expense.Date.Format(Dateformat),
+		"note":       expense.Note,
+		"created_by": expense.CreatedBy,
+	}
+}
+
+func formatTimestamp(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// withDeletedCategory returns a copy of the budget as it is stored after the
+// category was moved to the trash at the given time.
+func withDeletedCategory(budget models.Budget, catID string, at time.Time) *models.Budget {
+	categories := make([]models.Category, len(budget.Category))
+	copy(categories, budget.Category)
+	for i := range categories {
+		if categories[i].ID == catID {
+			categories[i].DeletedAt = &at
+		}
+	}
+	budget.Category = categories
+	budget.Version++
+	return &budget
+}
+
+// GetBudgetHistory returns one page of a budget's audit entries, newest
+// first, and the token of the next page. The history outlives the budget.
+func (s *BudgetService) GetBudgetHistory(ctx context.Context, req models.GetBudgetHistory) ([]models.AuditEntry, string, error) {
+	user, _, err := s.User.GetUser(ctx, req.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, "", err
+	}
+	if user == "" {
+		return nil, "", apperrors.NotFound("user not found")
+	}
+	query := models.HistoryQuery{UserID: req.UserID, BudgetID: req.BudgetID, Limit: req.PageSize}
+	// the history of a shared budget is kept under its owner, members can
+	// read it as long as they have access
+	budget, err := s.BudgetRepo.GetBudget(ctx, req.UserID, req.BudgetID)
+	if err != nil {
+		log.Println(err)
+		return nil, "", err
+	}
+	if budget != nil {
+		query.UserID = budget.UserID
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	query.Limit = min(query.Limit, maxPageSize)
+	if req.PageToken != "" {
+		cursor, err := decodeHistoryToken(req.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		query.After = &cursor
+	}
+	pageSize := query.Limit
+	// one extra entry tells whether there is a next page
+	query.Limit++
+	entries, err := s.Audit.GetBudgetHistory(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return nil, "", err
+	}
+	if len(entries) <= pageSize {
+		return entries, "", nil
+	}
+	entries = entries[:pageSize]
+	last := entries[pageSize-1]
+	next, err := encodeHistoryToken(models.HistoryCursor{ID: last.ID, Time: last.Time})
+	if err != nil {
+		log.Println(err)
+		return nil, "", err
+	}
+	return entries, next, nil
+}
+
+func encodeHistoryToken(cursor models.HistoryCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeHistoryToken(value string) (models.HistoryCursor, error) {
+	var cursor models.HistoryCursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, apperrors.InvalidArgument("invalid page token")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return cursor, apperrors.InvalidArgument("invalid page token")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// BackupUserData returns the backup document of the budgets the user owns.
+// Expenses and templates are kept in other collections and are not part of
+// it.
+func (s *BudgetService) BackupUserData(ctx context.Context, userID string) ([]byte, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	budgets, err := s.BudgetRepo.GetUserBudgets(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	backup := models.Backup{
+		Kind:      models.BackupKind,
+		Version:   models.BackupVersion,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+		Budgets:   make([]models.BackupBudget, len(budgets)),
+	}
+	for i, budget := range budgets {
+		backup.Budgets[i] = toBackupBudget(budget)
+	}
+	return json.MarshalIndent(backup, "", "  ")
+}
+
+// RestoreUserData adds the budgets of a backup to the user, who does not need
+// to be the user the backup was made of. A fresh restore keeps the IDs and
+// requires the user to own no budgets, not even in the trash. A merge gives
+// the budgets, categories and series new IDs. Either way the budgets must not
+// overlap with each other or the user's existing budgets.
+func (s *BudgetService) RestoreUserData(ctx context.Context, restore models.RestoreUserData) (*models.RestoreResult, error) {
+	user, _, err := s.User.GetUser(ctx, restore.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	if restore.Mode == "" {
+		restore.Mode = models.RestoreFresh
+	}
+	if restore.Mode != models.RestoreFresh && restore.Mode != models.RestoreMerge {
+		return nil, apperrors.InvalidArgument("invalid restore mode %q, expected fresh or merge", restore.Mode)
+	}
+	var backup models.Backup
+	if err := json.Unmarshal(restore.Data, &backup); err != nil {
+		return nil, apperrors.InvalidArgument("invalid backup: %v", err)
+	}
+	if backup.Kind != models.BackupKind {
+		return nil, apperrors.InvalidArgument("not a budget backup: kind is %q", backup.Kind)
+	}
+	if backup.Version < 1 || backup.Version > models.BackupVersion {
+		return nil, apperrors.InvalidArgument("unsupported backup version %d, expected at most %d", backup.Version, models.BackupVersion)
+	}
+	budgets, err := fromBackupBudgets(backup, restore.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := s.Locks.Lock(ctx, restore.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer unlock()
+	existing, err := s.BudgetRepo.GetUserBudgets(ctx, restore.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	ids := make(map[string]string, len(budgets))
+	for _, budget := range budgets {
+		ids[budget.ID] = budget.ID
+	}
+	if restore.Mode == models.RestoreFresh {
+		if len(existing) > 0 {
+			return nil, apperrors.FailedPrecondition("user already has budgets, restore with merge instead")
+		}
+	} else {
+		ids, err = remapIDs(budgets)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+	for i, budget := range budgets {
+		if budget.DeletedAt != nil {
+			continue
+		}
+		for _, other := range existing {
+			if other.DeletedAt == nil && doTasksOverlap(other, budget) {
+				return nil, apperrors.AlreadyExists("budget %s overlaps with an existing budget: %s", budget.Name, other.Name)
+			}
+		}
+		for _, other := range budgets[:i] {
+			if other.DeletedAt == nil && doTasksOverlap(other, budget) {
+				return nil, apperrors.AlreadyExists("budget %s overlaps with budget %s of the backup", budget.Name, other.Name)
+			}
+		}
+	}
+
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.InsertBudgets(ctx, budgets); err != nil {
+			return nil, err
+		}
+		var events []models.Event
+		for i := range budgets {
+			if budgets[i].DeletedAt == nil {
+				events = append(events, budgetEvents(models.EventBudgetCreated, nil, &budgets[i])...)
+			}
+			if err := s.audit(ctx, restore.UserID, "RestoreUserData", nil, &budgets[i]); err != nil {
+				return nil, err
+			}
+		}
+		return events, nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &models.RestoreResult{BudgetIDs: ids}, nil
+}
+
+// remapIDs gives the budgets, their categories and series new IDs, keeping
+// the links between renewed budgets. It returns the new ID of every budget
+// by its old one.
+func remapIDs(budgets []models.Budget) (map[string]string, error) {
+	ids := make(map[string]string, len(budgets))
+	series := make(map[string]string)
+	for i := range budgets {
+		id, err := newID()
+		if err != nil {
+			return nil, err
+		}
+		ids[budgets[i].ID] = id
+		if seriesID := budgets[i].SeriesID; seriesID != "" && series[seriesID] == "" {
+			series[seriesID], err = newID()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := range budgets {
+		budget := &budgets[i]
+		budget.ID = ids[budget.ID]
+		budget.SeriesID = series[budget.SeriesID]
+		// a successor missing from the backup is renewed again
+		budget.NextID = ids[budget.NextID]
+		for j := range budget.Category {
+			categoryID, err := newID()
+			if err != nil {
+				return nil, err
+			}
+			budget.Category[j].ID = categoryID
+		}
+	}
+	return ids, nil
+}
+
+func toBackupBudget(budget models.Budget) models.BackupBudget {
+	backup := models.BackupBudget{
+		ID:         budget.ID,
+		Name:       budget.Name,
+		Limit:      budget.Limit.String(),
+		Currency:   budget.Currency,
+		Allocation: budget.Allocation,
+		StartDate:  budget.StartDate.UTC(),
+		EndDate:    budget.EndDate.UTC(),
+		Categories: make([]models.BackupCategory, len(budget.Category)),
+		SeriesID:   budget.SeriesID,
+		NextID:     budget.NextID,
+		Version:    budget.Version,
+		DeletedAt:  budget.DeletedAt,
+	}
+	if budget.Recurrence != nil {
+		backup.Recurrence = &models.BackupRecurrence{Period: budget.Recurrence.Period, Active: budget.Recurrence.Active}
+	}
+	for _, member := range budget.Members {
+		backup.Members = append(backup.Members, models.BackupMember{UserID: member.UserID, Role: member.Role, AddedAt: member.AddedAt.UTC()})
+	}
+	for i, categ := range budget.Category {
+		backupCateg := models.BackupCategory{
+			ID:        categ.ID,
+			Name:      categ.Name,
+			Limit:     categ.Limit.String(),
+			DeletedAt: categ.DeletedAt,
+		}
+		if categ.Carried != 0 {
+			backupCateg.Carried = categ.Carried.String()
+		}
+		if categ.Rollover != nil {
+			backupCateg.Rollover = &models.BackupRollover{Mode: categ.Rollover.Mode}
+			if categ.Rollover.Cap != 0 {
+				backupCateg.Rollover.Cap = categ.Rollover.Cap.String()
+			}
+		}
+		backup.Categories[i] = backupCateg
+	}
+	return backup
+}
+
+// fromBackupBudgets validates the budgets of a backup and hands them over to
+// the user. The members are only kept when the backup is the user's own, no
+// one else can grant them access to the budgets.
+func fromBackupBudgets(data models.Backup, userID string) ([]models.Budget, error) {
+	budgets := make([]models.Budget, len(data.Budgets))
+	budgetIDs := make(map[string]bool, len(data.Budgets))
+	for i, backup := range data.Budgets {
+		if backup.ID == "" || budgetIDs[backup.ID] {
+			return nil, apperrors.InvalidArgument("budget %d of the backup has a missing or repeated id", i)
+		}
+		budgetIDs[backup.ID] = true
+		if backup.Name == "" {
+			return nil, apperrors.InvalidArgument("budget %s of the backup has no name", backup.ID)
+		}
+		if !backup.StartDate.Before(backup.EndDate) {
+			return nil, apperrors.InvalidArgument("budget %s of the backup ends before it starts", backup.ID)
+		}
+		if err := validateAllocation(backup.Allocation); err != nil {
+			return nil, err
+		}
+		limit, err := parseBackupMoney(backup.ID, "limit", backup.Limit)
+		if err != nil {
+			return nil, err
+		}
+		// budgets from before currencies were added have none
+		currency := backup.Currency
+		if currency != "" {
+			if currency, err = normalizeCurrency(currency, ""); err != nil {
+				return nil, err
+			}
+		}
+		budget := models.Budget{
+			ID:         backup.ID,
+			UserID:     userID,
+			Name:       backup.Name,
+			Limit:      limit,
+			Currency:   currency,
+			Allocation: backup.Allocation,
+			StartDate:  backup.StartDate.UTC(),
+			EndDate:    backup.EndDate.UTC(),
+			Category:   make([]models.Category, len(backup.Categories)),
+			SeriesID:   backup.SeriesID,
+			NextID:     backup.NextID,
+			Version:    max(backup.Version, 1),
+			DeletedAt:  backup.DeletedAt,
+		}
+		if backup.Recurrence != nil {
+			if addPeriod(budget.EndDate, backup.Recurrence.Period).IsZero() {
+				return nil, apperrors.InvalidArgument("budget %s of the backup has an invalid period: %s", backup.ID, backup.Recurrence.Period)
+			}
+			budget.Recurrence = &models.Recurrence{Period: backup.Recurrence.Period, Active: backup.Recurrence.Active}
+		}
+		if data.UserID == userID {
+			for _, member := range backup.Members {
+				if member.Role != models.RoleEditor && member.Role != models.RoleViewer {
+					return nil, apperrors.InvalidArgument("budget %s of the backup has an invalid member role: %s", backup.ID, member.Role)
+				}
+				budget.Members = append(budget.Members, models.Member{UserID: member.UserID, Role: member.Role, AddedAt: member.AddedAt})
+			}
+		}
+		categoryIDs := make(map[string]bool, len(backup.Categories))
+		// deleted categories may share the name of an active one
+		names := make(map[string]bool, len(backup.Categories))
+		for j, backupCateg := range backup.Categories {
+			if backupCateg.ID == "" || categoryIDs[backupCateg.ID] {
+				return nil, apperrors.InvalidArgument("budget %s of the backup has a category with a missing or repeated id", backup.ID)
+			}
+			categoryIDs[backupCateg.ID] = true
+			if backupCateg.Name == "" {
+				return nil, apperrors.InvalidArgument("budget %s of the backup has a category without a name", backup.ID)
+			}
+			if backupCateg.DeletedAt == nil {
+				if names[backupCateg.Name] {
+					return nil, apperrors.InvalidArgument("budget %s of the backup lists category %s twice", backup.ID, backupCateg.Name)
+				}
+				names[backupCateg.Name] = true
+			}
+			categ := models.Category{
+				ID:        backupCateg.ID,
+				Name:      backupCateg.Name,
+				DeletedAt: backupCateg.DeletedAt,
+			}
+			if categ.Limit, err = parseBackupMoney(backup.ID, "category limit", backupCateg.Limit); err != nil {
+				return nil, err
+			}
+			if backupCateg.Carried != "" {
+				if categ.Carried, err = parseBackupMoney(backup.ID, "carried amount", backupCateg.Carried); err != nil {
+					return nil, err
+				}
+			}
+			if backupCateg.Rollover != nil {
+				categ.Rollover = &models.Rollover{Mode: backupCateg.Rollover.Mode}
+				if backupCateg.Rollover.Cap != "" {
+					if categ.Rollover.Cap, err = parseBackupMoney(backup.ID, "rollover cap", backupCateg.Rollover.Cap); err != nil {
+						return nil, err
+					}
+				}
+				categ.Rollover = absRollover(categ.Rollover)
+				if err := validateRollover(categ.Rollover); err != nil {
+					return nil, err
+				}
+			}
+			budget.Category[j] = categ
+		}
+		budgets[i] = budget
+	}
+	return budgets, nil
+}
+
+func parseBackupMoney(budgetID, field, value string) (models.Money, error) {
+	amount, err := models.ParseMoney(value)
+	if err != nil {
+		return 0, apperrors.InvalidArgument("budget %s of the backup has an invalid %s: %v", budgetID, field, err)
+	}
+	return amount, nil
+}
@@ -0,0 +1,342 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func date(value string) time.Time {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Budget IDs of the backups, the repositories only accept object IDs.
+const (
+	b1 = "650000000000000000000001"
+	b2 = "650000000000000000000002"
+)
+
+func backupBudget(id, name, start, end string) models.BackupBudget {
+	return models.BackupBudget{
+		ID:        id,
+		Name:      name,
+		Limit:     "100.00",
+		StartDate: date(start),
+		EndDate:   date(end),
+		Categories: []models.BackupCategory{
+			{ID: "7" + id[1:], Name: "food", Limit: "40.00"},
+		},
+		Version: 1,
+	}
+}
+
+func marshalBackup(t *testing.T, userID string, budgets ...models.BackupBudget) []byte {
+	t.Helper()
+	data, err := json.Marshal(models.Backup{
+		Kind:      models.BackupKind,
+		Version:   models.BackupVersion,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+		Budgets:   budgets,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// userBudgets returns the budgets of the user, including the trash, by ID.
+func userBudgets(t *testing.T, repos testRepos, userID string) map[string]models.Budget {
+	t.Helper()
+	budgets, err := repos.budgets.GetUserBudgets(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := make(map[string]models.Budget, len(budgets))
+	for _, budget := range budgets {
+		byID[budget.ID] = budget
+	}
+	return byID
+}
+
+func TestRestoreUserDataFresh(t *testing.T) {
+	ctx := context.Background()
+	source, _ := newTestService()
+	id := mustAddBudget(t, source, models.CreateBudget{UserID: "u1", Name: "monthly", Limit: 10000, Period: "month", Recurring: true})
+	if _, _, err := source.AddCategory(ctx, models.CreateCategory{UserID: "u1", BudgetID: id, Name: "food", Limit: 4000,
+		Rollover: &models.Rollover{Mode: "both", Cap: 500}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.ShareBudget(ctx, models.ShareBudget{UserID: "u1", BudgetID: id, MemberID: "m1", Role: models.RoleViewer}); err != nil {
+		t.Fatal(err)
+	}
+	trashed := mustAddBudget(t, source, models.CreateBudget{UserID: "u1", Name: "old", Limit: 5000, StartDate: "2020-01-01", EndDate: "2020-02-01"})
+	if err := source.DeleteBudget(ctx, "u1", trashed, nil); err != nil {
+		t.Fatal(err)
+	}
+	data, err := source.BackupUserData(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, err := source.GetBudget(ctx, "u1", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		members int
+	}{
+		{"own backup keeps the members", "u1", 1},
+		{"backup of another user drops the members", "u2", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repos := newTestService()
+			result, err := s.RestoreUserData(ctx, models.RestoreUserData{UserID: tt.userID, Data: data})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result.BudgetIDs) != 2 || result.BudgetIDs[id] != id || result.BudgetIDs[trashed] != trashed {
+				t.Fatalf("budget IDs %v, want the IDs of the backup", result.BudgetIDs)
+			}
+			budgets := userBudgets(t, repos, tt.userID)
+			restored := budgets[id]
+			if restored.UserID != tt.userID || restored.SeriesID != original.SeriesID || restored.Recurrence == nil {
+				t.Fatalf("restored budget %+v", restored)
+			}
+			if len(restored.Category) != 1 || restored.Category[0].ID != original.Category[0].ID ||
+				restored.Category[0].Rollover == nil || restored.Category[0].Rollover.Cap != 500 {
+				t.Fatalf("restored categories %+v, want %+v", restored.Category, original.Category)
+			}
+			if len(restored.Members) != tt.members {
+				t.Fatalf("restored members %+v, want %d", restored.Members, tt.members)
+			}
+			if budgets[trashed].DeletedAt == nil {
+				t.Fatal("the trashed budget was restored out of the trash")
+			}
+		})
+	}
+}
+
+func TestRestoreUserDataFreshRequiresNoBudgets(t *testing.T) {
+	ctx := context.Background()
+	data := marshalBackup(t, "u1", backupBudget(b1, "january", "2025-01-01", "2025-02-01"))
+	tests := []struct {
+		name  string
+		trash bool
+	}{
+		{"active budget", false},
+		{"budget in the trash", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repos := newTestService()
+			id := mustAddBudget(t, s, models.CreateBudget{UserID: "u1", Name: "other", Limit: 100, StartDate: "2024-01-01", EndDate: "2024-02-01"})
+			if tt.trash {
+				if err := s.DeleteBudget(ctx, "u1", id, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := s.RestoreUserData(ctx, models.RestoreUserData{UserID: "u1", Data: data})
+			if !errors.Is(err, apperrors.ErrFailedPrecondition) {
+				t.Fatalf("fresh restore: %v, want a failed precondition", err)
+			}
+			if budgets := userBudgets(t, repos, "u1"); len(budgets) != 1 {
+				t.Fatalf("%d budgets after a failed restore", len(budgets))
+			}
+			if _, err := s.RestoreUserData(ctx, models.RestoreUserData{UserID: "u1", Data: data, Mode: models.RestoreMerge}); err != nil {
+				t.Fatalf("merge restore: %v", err)
+			}
+		})
+	}
+}
+
+func TestRestoreUserDataMerge(t *testing.T) {
+	ctx := context.Background()
+	january := backupBudget(b1, "january", "2025-01-01", "2025-02-01")
+	january.SeriesID = "s1"
+	january.NextID = b2
+	january.Recurrence = &models.BackupRecurrence{Period: "month"}
+	february := backupBudget(b2, "february", "2025-02-01", "2025-03-01")
+	february.SeriesID = "s1"
+	february.NextID = "gone"
+	february.Recurrence = &models.BackupRecurrence{Period: "month", Active: true}
+	data := marshalBackup(t, "u1", january, february)
+
+	s, repos := newTestService()
+	existing := mustAddBudget(t, s, models.CreateBudget{UserID: "u1", Name: "december", Limit: 100, StartDate: "2024-12-01", EndDate: "2025-01-01"})
+	// restoring twice must not collide with the first copy's IDs
+	if _, err := s.RestoreUserData(ctx, models.RestoreUserData{UserID: "u2", Data: data, Mode: models.RestoreMerge}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := s.RestoreUserData(ctx, models.RestoreUserData{UserID: "u1", Data: data, Mode: models.RestoreMerge})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	budgets := userBudgets(t, repos, "u1")
+	if len(budgets) != 3 {
+		t.Fatalf("%d budgets, want the existing one and two restored", len(budgets))
+	}
+	if _, ok := budgets[existing]; !ok {
+		t.Fatal("the existing budget is gone")
+	}
+	newJanuary, ok := budgets[result.BudgetIDs[b1]]
+	if !ok || newJanuary.ID == b1 {
+		t.Fatalf("budget %s restored as %q", b1, result.BudgetIDs[b1])
+	}
+	newFebruary, ok := budgets[result.BudgetIDs[b2]]
+	if !ok || newFebruary.ID == b2 {
+		t.Fatalf("budget %s restored as %q", b2, result.BudgetIDs[b2])
+	}
+	if newJanuary.SeriesID == "" || newJanuary.SeriesID == "s1" || newJanuary.SeriesID != newFebruary.SeriesID {
+		t.Fatalf("series %q and %q, want one new series", newJanuary.SeriesID, newFebruary.SeriesID)
+	}
+	if newJanuary.NextID != newFebruary.ID {
+		t.Fatalf("next of january is %q, want %q", newJanuary.NextID, newFebruary.ID)
+	}
+	if newFebruary.NextID != "" {
+		t.Fatalf("next of february is %q, want it cleared because the successor is not in the backup", newFebruary.NextID)
+	}
+	for _, budget := range []models.Budget{newJanuary, newFebruary} {
+		if budget.Category[0].ID == "7"+b1[1:] || budget.Category[0].ID == "7"+b2[1:] {
+			t.Fatalf("category of %s kept its ID %s", budget.Name, budget.Category[0].ID)
+		}
+	}
+	if newJanuary.Category[0].ID == newFebruary.Category[0].ID {
+		t.Fatal("restored categories share an ID")
+	}
+}
+
+func TestRestoreUserDataValidation(t *testing.T) {
+	ctx := context.Background()
+	withCurrency := func(currency string) models.BackupBudget {
+		budget := backupBudget(b1, "january", "2025-01-01", "2025-02-01")
+		budget.Currency = currency
+		return budget
+	}
+	trashed := backupBudget(b2, "deleted", "2025-01-15", "2025-02-15")
+	trashed.DeletedAt = new(time.Time)
+	withMember := func(role string) models.BackupBudget {
+		budget := backupBudget(b1, "january", "2025-01-01", "2025-02-01")
+		budget.Members = []models.BackupMember{{UserID: "m1", Role: role}}
+		return budget
+	}
+
+	tests := []struct {
+		name     string
+		existing *models.CreateBudget
+		data     []byte
+		mode     string
+		err      error
+		// check, if set, inspects the restored budget b1
+		check func(t *testing.T, budget models.Budget)
+	}{
+		{
+			name: "lower-case currency",
+			data: marshalBackup(t, "u1", withCurrency("eur")),
+			check: func(t *testing.T, budget models.Budget) {
+				if budget.Currency != "EUR" {
+					t.Fatalf("currency %q, want EUR", budget.Currency)
+				}
+			},
+		},
+		{
+			name: "unknown currency",
+			data: marshalBackup(t, "u1", withCurrency("XYZ")),
+			err:  apperrors.ErrInvalidArgument,
+		},
+		{
+			name: "currency without cents",
+			data: marshalBackup(t, "u1", withCurrency("JPY")),
+			err:  apperrors.ErrInvalidArgument,
+		},
+		{
+			name: "overlap within the backup",
+			data: marshalBackup(t, "u1",
+				backupBudget(b1, "january", "2025-01-01", "2025-02-01"),
+				backupBudget(b2, "mid-january", "2025-01-15", "2025-02-15")),
+			err: apperrors.ErrAlreadyExists,
+		},
+		{
+			name: "overlap with a budget in the trash of the backup",
+			data: marshalBackup(t, "u1", backupBudget(b1, "january", "2025-01-01", "2025-02-01"), trashed),
+		},
+		{
+			name:     "overlap with an existing budget",
+			existing: &models.CreateBudget{UserID: "u1", Name: "existing", Limit: 100, StartDate: "2025-01-20", EndDate: "2025-02-20"},
+			data:     marshalBackup(t, "u1", backupBudget(b1, "january", "2025-01-01", "2025-02-01")),
+			mode:     models.RestoreMerge,
+			err:      apperrors.ErrAlreadyExists,
+		},
+		{
+			name: "repeated budget ID",
+			data: marshalBackup(t, "u1",
+				backupBudget(b1, "january", "2025-01-01", "2025-02-01"),
+				backupBudget(b1, "february", "2025-02-01", "2025-03-01")),
+			err: apperrors.ErrInvalidArgument,
+		},
+		{
+			name: "invalid member role of the own backup",
+			data: marshalBackup(t, "u1", withMember("owner")),
+			err:  apperrors.ErrInvalidArgument,
+		},
+		{
+			name: "members of another user's backup are dropped",
+			data: marshalBackup(t, "u2", withMember("owner")),
+			check: func(t *testing.T, budget models.Budget) {
+				if len(budget.Members) != 0 {
+					t.Fatalf("members %+v, want none", budget.Members)
+				}
+			},
+		},
+		{
+			name: "newer backup version",
+			data: []byte(`{"kind":"moneykeeper.budget.backup","version":2}`),
+			err:  apperrors.ErrInvalidArgument,
+		},
+		{
+			name: "other kind",
+			data: []byte(`{"kind":"something","version":1}`),
+			err:  apperrors.ErrInvalidArgument,
+		},
+		{
+			name: "invalid mode",
+			data: marshalBackup(t, "u1", backupBudget(b1, "january", "2025-01-01", "2025-02-01")),
+			mode: "replace",
+			err:  apperrors.ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repos := newTestService()
+			before := 0
+			if tt.existing != nil {
+				mustAddBudget(t, s, *tt.existing)
+				before = 1
+			}
+			_, err := s.RestoreUserData(ctx, models.RestoreUserData{UserID: "u1", Data: tt.data, Mode: tt.mode})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("restore: %v, want %v", err, tt.err)
+			}
+			budgets := userBudgets(t, repos, "u1")
+			if tt.err != nil && len(budgets) != before {
+				t.Fatalf("%d budgets after a failed restore, want %d", len(budgets), before)
+			}
+			if tt.check != nil {
+				tt.check(t, budgets[b1])
+			}
+		})
+	}
+}
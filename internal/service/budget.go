@@ -2,23 +2,46 @@ package service
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"log"
+	"math"
 	"time"
 
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
 	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
 )
 
 type BudgetRepository interface {
 	AddBudget(ctx context.Context, budget models.Budget) (string, error)
 	GetBudgetList(ctx context.Context, userID string) ([]models.Budget, error)
+	ListBudgets(ctx context.Context, query models.BudgetQuery) ([]models.Budget, error)
+	// GetBudget, ListBudgets and GetBudgetSeries also return the budgets the
+	// user is a member of, the other methods act on the user's own budgets.
 	GetBudget(ctx context.Context, userID, budgetID string) (*models.Budget, error)
-	AddCategory(ctx context.Context, categ models.CreateCategory) error
-	DeleteCategory(ctx context.Context, userID, budgetID, catID string) error
-	DeleteBudget(ctx context.Context, userID, budgetID string) error
+	// The mutating methods only apply when the budget is still at version and
+	// return a Conflict error otherwise. They increment the version.
+	AddCategory(ctx context.Context, categ models.CreateCategory, version int64) error
+	DeleteCategory(ctx context.Context, userID, budgetID, catID string, version int64) error
+	DeleteBudget(ctx context.Context, userID, budgetID string, version int64) error
 	UpdateBudget(ctx context.Context, update models.Budget) error
-	UpdateCategory(ctx context.Context, userID, budgetID string, update models.Category) error
+	UpdateCategory(ctx context.Context, userID, budgetID string, update models.Category, version int64) error
+	GetDueRecurringBudgets(ctx context.Context, now time.Time) ([]models.Budget, error)
+	RenewBudget(ctx context.Context, budgetID string, next models.Budget) (string, error)
+	GetBudgetSeries(ctx context.Context, userID, seriesID string) ([]models.Budget, error)
+	StopRecurrence(ctx context.Context, userID, seriesID string) error
+	// Deleted budgets and categories are hidden from the methods above until
+	// they are restored or purged.
+	GetDeletedBudgets(ctx context.Context, userID string) ([]models.Budget, error)
+	GetDeletedCategories(ctx context.Context, userID string) ([]models.DeletedCategory, error)
+	RestoreBudget(ctx context.Context, userID, budgetID string, version int64) error
+	RestoreCategory(ctx context.Context, userID, budgetID, catID string, version int64) error
+	UpdateMembers(ctx context.Context, userID, budgetID string, members []models.Member, version int64) error
+	PurgeDeleted(ctx context.Context, before time.Time) ([]models.Budget, error)
+	// GetUserBudgets returns all budgets the user owns, including the ones in
+	// the trash and the deleted categories.
+	GetUserBudgets(ctx context.Context, userID string) ([]models.Budget, error)
+	// InsertBudgets keeps the IDs, versions and deleted markers of the
+	// budgets and returns an AlreadyExists error when an ID is taken.
+	InsertBudgets(ctx context.Context, budgets []models.Budget) error
 }
 
 type UserService interface {
@@ -26,12 +49,21 @@ type UserService interface {
 }
 
 type BudgetService struct {
-	BudgetRepo BudgetRepository
-	User       UserService
+	BudgetRepo      BudgetRepository
+	ExpenseRepo     ExpenseRepository
+	Templates       TemplateRepository
+	Audit           AuditRepository
+	Outbox          OutboxRepository
+	Tx              Transactor
+	Feed            EventFeed
+	Locks           Locker
+	User            UserService
+	Rates           ExchangeRateProvider
+	DefaultCurrency string
 }
 
-func NewBudgetService(repo BudgetRepository, user UserService) *BudgetService {
-	return &BudgetService{BudgetRepo: repo, User: user}
+func NewBudgetService(repo BudgetRepository, expenseRepo ExpenseRepository, templates TemplateRepository, audit AuditRepository, outbox OutboxRepository, tx Transactor, feed EventFeed, locks Locker, user UserService, rates ExchangeRateProvider, defaultCurrency string) *BudgetService {
+	return &BudgetService{BudgetRepo: repo, ExpenseRepo: expenseRepo, Templates: templates, Audit: audit, Outbox: outbox, Tx: tx, Feed: feed, Locks: locks, User: user, Rates: rates, DefaultCurrency: defaultCurrency}
 }
 
 const (
@@ -45,115 +77,236 @@ func (s *BudgetService) AddBudget(ctx context.Context, budget models.CreateBudge
 		return "", err
 	}
 	if user == "" {
-		return "", errors.New("user not found")
+		return "", apperrors.NotFound("user not found")
 	}
+	newBudget, err := s.newBudget(budget)
+	if err != nil {
+		return "", err
+	}
+	created, err := s.createBudget(ctx, "AddBudget", newBudget)
+	if err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// newBudget validates the request and returns the budget it describes,
+// without categories.
+func (s *BudgetService) newBudget(budget models.CreateBudget) (models.Budget, error) {
 	if budget.Limit < 0 {
 		budget.Limit *= -1
 	}
+	if budget.Recurring && budget.Period == "" {
+		return models.Budget{}, apperrors.InvalidArgument("recurring budgets require a period")
+	}
+	if budget.Allocation == "" {
+		budget.Allocation = models.AllocationUnrestricted
+	}
+	if err := validateAllocation(budget.Allocation); err != nil {
+		return models.Budget{}, err
+	}
+	currency, err := normalizeCurrency(budget.Currency, s.DefaultCurrency)
+	if err != nil {
+		return models.Budget{}, err
+	}
 	var start, end time.Time
 	if budget.Period != "" {
 		start, end = s.getPeriodDates(budget.Period)
 		if start.IsZero() || end.IsZero() {
-			return "", errors.New("invalid period")
+			return models.Budget{}, apperrors.InvalidArgument("invalid period: %s", budget.Period)
 		}
 	} else {
-		start, err = time.Parse(Dateformat, budget.StartDate)
+		start, err = parseDate(budget.StartDate)
 		if err != nil {
 			log.Println(err)
-			return "", err
+			return models.Budget{}, err
 		}
-		end, err = time.Parse(Dateformat, budget.EndDate)
+		end, err = parseDate(budget.EndDate)
 		if err != nil {
 			log.Println(err)
-			return "", err
+			return models.Budget{}, err
 		}
 		if !start.Before(end) {
 			start, end = end, start
 		}
 	}
 	newBudget := models.Budget{
-		UserID:    budget.UserID,
-		Name:      budget.Name,
-		Limit:     float64(budget.Limit),
-		StartDate: start,
-		EndDate:   end,
-		Category:  []models.Category{},
+		UserID:     budget.UserID,
+		Name:       budget.Name,
+		Limit:      budget.Limit,
+		Currency:   currency,
+		Allocation: budget.Allocation,
+		StartDate:  start,
+		EndDate:    end,
+		Category:   []models.Category{},
+	}
+	if budget.Recurring {
+		newBudget.Recurrence = &models.Recurrence{Period: budget.Period, Active: true}
+		newBudget.SeriesID, err = newID()
+		if err != nil {
+			log.Println(err)
+			return models.Budget{}, err
+		}
 	}
-	budgets, err := s.GetBudgetList(ctx, budget.UserID)
+	return newBudget, nil
+}
+
+// createBudget inserts the budget together with its categories, unless it
+// overlaps with another budget of the user. The operation names the change
+// in the audit log.
+func (s *BudgetService) createBudget(ctx context.Context, operation string, newBudget models.Budget) (*models.Budget, error) {
+	// the lock keeps concurrent requests from adding overlapping budgets
+	// between the check and the insert
+	unlock, err := s.Locks.Lock(ctx, newBudget.UserID)
 	if err != nil {
-		return "", err
+		log.Println(err)
+		return nil, err
 	}
-	for _, budget := range budgets {
-		if doTasksOverlap(budget, newBudget) {
-			return "", fmt.Errorf("task overlaps with an existing task: %s", budget.Name)
+	defer unlock()
+	if err := s.checkOverlap(ctx, newBudget); err != nil {
+		return nil, err
+	}
+	var created *models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		id, err := s.BudgetRepo.AddBudget(ctx, newBudget)
+		if err != nil {
+			return nil, err
+		}
+		// read back for the category IDs assigned by the repository
+		created, err = s.BudgetRepo.GetBudget(ctx, newBudget.UserID, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, newBudget.UserID, operation, nil, created); err != nil {
+			return nil, err
 		}
+		return budgetEvents(models.EventBudgetCreated, nil, created), nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return created, nil
+}
+
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(Dateformat, value)
+	if err != nil {
+		return time.Time{}, apperrors.InvalidArgument("invalid date %q, expected format %s", value, Dateformat)
 	}
-	id, err := s.BudgetRepo.AddBudget(ctx, newBudget)
+	return date, nil
+}
+
+// checkOverlap must be called with the user's lock held.
+func (s *BudgetService) checkOverlap(ctx context.Context, budget models.Budget) error {
+	budgets, err := s.BudgetRepo.GetBudgetList(ctx, budget.UserID)
 	if err != nil {
 		log.Println(err)
-		return "", err
+		return err
 	}
-	return id, nil
+	for _, existing := range budgets {
+		if existing.ID != budget.ID && doTasksOverlap(existing, budget) {
+			return apperrors.AlreadyExists("budget overlaps with an existing budget: %s", existing.Name)
+		}
+	}
+	return nil
 }
 
 func doTasksOverlap(existingBudget, newBudget models.Budget) bool {
 	return existingBudget.EndDate.After(newBudget.StartDate) && existingBudget.StartDate.Before(newBudget.EndDate)
 }
 func (s *BudgetService) getPeriodDates(period string) (time.Time, time.Time) {
-	now := time.Now().UTC()
+	start := time.Now().UTC()
+	end := addPeriod(start, period)
+	if end.IsZero() {
+		return time.Time{}, time.Time{}
+	}
+	return start, end
+}
+
+func addPeriod(start time.Time, period string) time.Time {
 	switch period {
 	case "day":
-		start := now
-		return start, start.AddDate(0, 0, 1)
+		return start.AddDate(0, 0, 1)
 	case "week":
-		start := now
-		return start, start.AddDate(0, 0, 7)
+		return start.AddDate(0, 0, 7)
 	case "month":
-		start := now
-		return start, start.AddDate(0, 1, 0)
+		return start.AddDate(0, 1, 0)
 	case "year":
-		start := now
-		return start, start.AddDate(1, 0, 0)
+		return start.AddDate(1, 0, 0)
 	default:
-		return time.Time{}, time.Time{}
+		return time.Time{}
 	}
 }
-func (s *BudgetService) AddCategory(ctx context.Context, categ models.CreateCategory) (*models.Budget, error) {
+
+func (s *BudgetService) AddCategory(ctx context.Context, categ models.CreateCategory) (*models.Budget, []string, error) {
 	user, _, err := s.User.GetUser(ctx, categ.UserID)
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
 	if user == "" {
-		return nil, errors.New("user not found")
+		return nil, nil, apperrors.NotFound("user not found")
 	}
-	budget, err := s.BudgetRepo.GetBudget(ctx, categ.UserID, categ.BudgetID)
+	budget, err := s.budgetForRole(ctx, categ.UserID, categ.BudgetID, models.RoleEditor)
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
-	if budget == nil {
-		return nil, errors.New("budget is not found")
+	if err := checkVersion(budget, categ.Version); err != nil {
+		return nil, nil, err
 	}
 	if s.checkForDuplicateCategory(categ.Name, budget.Category) {
-		return nil, fmt.Errorf("category with name %s is already added to this budget", categ.Name)
+		return nil, nil, apperrors.AlreadyExists("category with name %s is already added to this budget", categ.Name)
 	}
 	if categ.Limit < 0 {
 		categ.Limit *= -1
 	}
-
-	err = s.BudgetRepo.AddCategory(ctx, categ)
+	categ.Rollover = absRollover(categ.Rollover)
+	if err := validateRollover(categ.Rollover); err != nil {
+		return nil, nil, err
+	}
+	warnings, err := checkCategoryAllocation(budget, "", categ.Limit)
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	newBudget, err := s.BudgetRepo.GetBudget(ctx, categ.UserID, categ.BudgetID)
+	// members change the budget on behalf of its owner
+	actor := categ.UserID
+	categ.UserID = budget.UserID
+	var newBudget *models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.AddCategory(ctx, categ, budget.Version); err != nil {
+			return nil, err
+		}
+		var err error
+		newBudget, err = s.BudgetRepo.GetBudget(ctx, categ.UserID, categ.BudgetID)
+		if err != nil {
+			return nil, err
+		}
+		var addedID string
+		for _, added := range newBudget.Category {
+			if added.Name == categ.Name {
+				addedID = added.ID
+			}
+		}
+		if err := s.audit(ctx, actor, "AddCategory", budget, newBudget); err != nil {
+			return nil, err
+		}
+		return categoryEvents(models.EventCategoryAdded, addedID, budget, newBudget), nil
+	})
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
-	return newBudget, nil
+	return newBudget, warnings, nil
+}
+
+// checkVersion enforces the version a client expects the budget to be at.
+func checkVersion(budget *models.Budget, expected *int64) error {
+	if expected != nil && *expected != budget.Version {
+		return apperrors.FailedPrecondition("budget version mismatch: expected %d, current %d", *expected, budget.Version)
+	}
+	return nil
 }
 
 func (s *BudgetService) checkForDuplicateCategory(newCateg string, categs []models.Category) bool {
@@ -172,52 +325,126 @@ func (s *BudgetService) GetBudget(ctx context.Context, userID, budgetID string)
 		return nil, err
 	}
 	if user == "" {
-		return nil, errors.New("user not found")
+		return nil, apperrors.NotFound("user not found")
 	}
-	budget, err := s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+	budget, err := s.budgetForRole(ctx, userID, budgetID, models.RoleViewer)
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
-	if budget == nil {
-		return nil, errors.New("budget is not found")
-	}
 	return budget, nil
 }
 
-func (s *BudgetService) GetBudgetList(ctx context.Context, userID string) ([]models.Budget, error) {
-	user, _, err := s.User.GetUser(ctx, userID)
+func (s *BudgetService) GetBudgetSummary(ctx context.Context, userID, budgetID string) (*models.BudgetSummary, error) {
+	budget, err := s.GetBudget(ctx, userID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	expenses, err := s.ExpenseRepo.GetExpenses(ctx, budget.UserID, budgetID, "")
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
+	spentByCategory := make(map[string]models.Money, len(budget.Category))
+	var spent models.Money
+	for _, expense := range expenses {
+		spentByCategory[expense.CategoryID] += expense.Amount
+		spent += expense.Amount
+	}
+
+	summary := models.BudgetSummary{
+		BudgetID:    budget.ID,
+		Name:        budget.Name,
+		Limit:       budget.Limit,
+		Spent:       spent,
+		Remaining:   budget.Limit - spent,
+		PercentUsed: spent.PercentOf(budget.Limit),
+		Unallocated: budget.Limit,
+		Categories:  make([]models.CategorySummary, 0, len(budget.Category)),
+	}
+	summary.DaysElapsed, summary.DaysRemaining = budgetDays(*budget, time.Now().UTC())
+	for _, categ := range budget.Category {
+		categSpent := spentByCategory[categ.ID]
+		limit := categ.EffectiveLimit()
+		summary.Unallocated -= categ.Limit
+		summary.Categories = append(summary.Categories, models.CategorySummary{
+			CategoryID:  categ.ID,
+			Name:        categ.Name,
+			Limit:       limit,
+			Spent:       categSpent,
+			Remaining:   limit - categSpent,
+			PercentUsed: categSpent.PercentOf(limit),
+		})
+	}
+	return &summary, nil
+}
+
+// budgetDays returns the number of whole days already passed in the budget
+// window and the number of days left, both clamped to the window.
+func budgetDays(budget models.Budget, now time.Time) (int, int) {
+	if now.Before(budget.StartDate) {
+		now = budget.StartDate
+	}
+	if now.After(budget.EndDate) {
+		now = budget.EndDate
+	}
+	elapsed := int(now.Sub(budget.StartDate).Hours() / 24)
+	remaining := int(math.Ceil(budget.EndDate.Sub(now).Hours() / 24))
+	return elapsed, remaining
+}
+
+// GetBudgetList returns one page of the user's budgets and the token of the
+// next page, which is empty on the last page. Without a page size or token
+// all budgets are returned at once.
+func (s *BudgetService) GetBudgetList(ctx context.Context, list models.ListBudgets) ([]models.Budget, string, error) {
+	user, _, err := s.User.GetUser(ctx, list.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, "", err
+	}
 	if user == "" {
-		return nil, errors.New("user not found")
+		return nil, "", apperrors.NotFound("user not found")
+	}
+	query, err := newBudgetQuery(list)
+	if err != nil {
+		return nil, "", err
+	}
+	pageSize := query.Limit
+	if pageSize > 0 {
+		// one extra budget tells whether there is a next page
+		query.Limit++
 	}
-	budgetList, err := s.BudgetRepo.GetBudgetList(ctx, userID)
+	budgetList, err := s.BudgetRepo.ListBudgets(ctx, query)
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, "", err
+	}
+	if pageSize == 0 || len(budgetList) <= pageSize {
+		return budgetList, "", nil
+	}
+	budgetList = budgetList[:pageSize]
+	next, err := encodePageToken(list, query, budgetList[pageSize-1])
+	if err != nil {
+		log.Println(err)
+		return nil, "", err
 	}
-	return budgetList, nil
+	return budgetList, next, nil
 }
 
-func (s *BudgetService) DeleteCategory(ctx context.Context, userID, budgetID, catID string) error {
+func (s *BudgetService) DeleteCategory(ctx context.Context, userID, budgetID, catID string, version *int64) error {
 	user, _, err := s.User.GetUser(ctx, userID)
 	if err != nil {
 		log.Println(err)
 		return err
 	}
 	if user == "" {
-		return errors.New("user not found")
+		return apperrors.NotFound("user not found")
 	}
-	budget, err := s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+	budget, err := s.budgetForRole(ctx, userID, budgetID, models.RoleEditor)
 	if err != nil {
-		log.Println(err)
 		return err
 	}
-	if budget == nil {
-		return errors.New("budget is not found")
+	if err := checkVersion(budget, version); err != nil {
+		return err
 	}
 	isExist := false
 	for _, categ := range budget.Category {
@@ -227,10 +454,19 @@ func (s *BudgetService) DeleteCategory(ctx context.Context, userID, budgetID, ca
 		}
 	}
 	if !isExist {
-		return errors.New("category is not found")
+		return apperrors.NotFound("category is not found")
 	}
 
-	err = s.BudgetRepo.DeleteCategory(ctx, userID, budgetID, catID)
+	deleted := withDeletedCategory(*budget, catID, time.Now().UTC())
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.DeleteCategory(ctx, budget.UserID, budgetID, catID, budget.Version); err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, userID, "DeleteCategory", budget, deleted); err != nil {
+			return nil, err
+		}
+		return categoryEvents(models.EventCategoryDeleted, catID, budget, deleted), nil
+	})
 	if err != nil {
 		log.Println(err)
 		return err
@@ -238,24 +474,34 @@ func (s *BudgetService) DeleteCategory(ctx context.Context, userID, budgetID, ca
 	return nil
 }
 
-func (s *BudgetService) DeleteBudget(ctx context.Context, userID, budgetID string) error {
+func (s *BudgetService) DeleteBudget(ctx context.Context, userID, budgetID string, version *int64) error {
 	user, _, err := s.User.GetUser(ctx, userID)
 	if err != nil {
 		log.Println(err)
 		return err
 	}
 	if user == "" {
-		return errors.New("user not found")
+		return apperrors.NotFound("user not found")
 	}
-	budget, err := s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+	budget, err := s.budgetForRole(ctx, userID, budgetID, models.RoleOwner)
 	if err != nil {
-		log.Println(err)
 		return err
 	}
-	if budget == nil {
-		return errors.New("budget is not found")
+	if err := checkVersion(budget, version); err != nil {
+		return err
 	}
-	err = s.BudgetRepo.DeleteBudget(ctx, userID, budgetID)
+	deleted, now := *budget, time.Now().UTC()
+	deleted.DeletedAt = &now
+	deleted.Version++
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.DeleteBudget(ctx, userID, budgetID, budget.Version); err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, userID, "DeleteBudget", budget, &deleted); err != nil {
+			return nil, err
+		}
+		return budgetEvents(models.EventBudgetDeleted, budget, &deleted), nil
+	})
 	if err != nil {
 		log.Println(err)
 		return err
@@ -263,26 +509,26 @@ func (s *BudgetService) DeleteBudget(ctx context.Context, userID, budgetID strin
 	return nil
 }
 
-func (s *BudgetService) UpdateBudget(ctx context.Context, update models.GetUpdateBudget) (*models.Budget, error) {
+func (s *BudgetService) UpdateBudget(ctx context.Context, update models.GetUpdateBudget) (*models.Budget, []string, error) {
 	user, _, err := s.User.GetUser(ctx, update.UserID)
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
 	if user == "" {
-		return nil, errors.New("user not found")
+		return nil, nil, apperrors.NotFound("user not found")
 	}
-	budget, err := s.BudgetRepo.GetBudget(ctx, update.UserID, update.BudgetID)
+	budget, err := s.budgetForRole(ctx, update.UserID, update.BudgetID, models.RoleEditor)
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
-	if budget == nil {
-		return nil, errors.New("budget is not found")
+	if err := checkVersion(budget, update.Version); err != nil {
+		return nil, nil, err
 	}
 	updates := models.Budget{
-		ID:     update.BudgetID,
-		UserID: update.UserID,
+		ID:      update.BudgetID,
+		UserID:  budget.UserID,
+		Version: budget.Version,
 	}
 	if update.Name != nil {
 		updates.Name = *update.Name
@@ -298,19 +544,19 @@ func (s *BudgetService) UpdateBudget(ctx context.Context, update models.GetUpdat
 		updates.Limit = budget.Limit
 	}
 	if update.Start != nil {
-		updates.StartDate, err = time.Parse(Dateformat, *update.Start)
+		updates.StartDate, err = parseDate(*update.Start)
 		if err != nil {
 			log.Println(err)
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		updates.StartDate = budget.StartDate
 	}
 	if update.End != nil {
-		updates.EndDate, err = time.Parse(Dateformat, *update.End)
+		updates.EndDate, err = parseDate(*update.End)
 		if err != nil {
 			log.Println(err)
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		updates.EndDate = budget.EndDate
@@ -318,35 +564,69 @@ func (s *BudgetService) UpdateBudget(ctx context.Context, update models.GetUpdat
 	if updates.EndDate.Before(updates.StartDate) {
 		updates.StartDate, updates.EndDate = updates.EndDate, updates.StartDate
 	}
-	err = s.BudgetRepo.UpdateBudget(ctx, updates)
-	if err != nil {
-		log.Println(err)
-		return nil, err
+	updates.Allocation = budget.Allocation
+	if update.Allocation != nil {
+		updates.Allocation = *update.Allocation
+		if err := validateAllocation(updates.Allocation); err != nil {
+			return nil, nil, err
+		}
 	}
-	budget, err = s.BudgetRepo.GetBudget(ctx, update.UserID, update.BudgetID)
-	if err != nil{
+	var warnings []string
+	// only a lower limit or a new policy can break the allocation
+	if updates.Limit < budget.Limit || updates.Allocation != budget.Allocation {
+		warnings, err = checkBudgetAllocation(updates.Allocation, updates.Limit, budget.Category)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if !updates.StartDate.Equal(budget.StartDate) || !updates.EndDate.Equal(budget.EndDate) {
+		unlock, err := s.Locks.Lock(ctx, budget.UserID)
+		if err != nil {
+			log.Println(err)
+			return nil, nil, err
+		}
+		defer unlock()
+		if err := s.checkOverlap(ctx, updates); err != nil {
+			return nil, nil, err
+		}
+	}
+	var updated *models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.UpdateBudget(ctx, updates); err != nil {
+			return nil, err
+		}
+		var err error
+		updated, err = s.BudgetRepo.GetBudget(ctx, update.UserID, update.BudgetID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, update.UserID, "UpdateBudget", budget, updated); err != nil {
+			return nil, err
+		}
+		return budgetEvents(models.EventBudgetUpdated, budget, updated), nil
+	})
+	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
-	return budget, nil
+	return updated, warnings, nil
 }
 
-func (s *BudgetService) UpdateCategory(ctx context.Context, update models.GetUpdateCategory) (*models.Budget, error) {
+func (s *BudgetService) UpdateCategory(ctx context.Context, update models.GetUpdateCategory) (*models.Budget, []string, error) {
 	user, _, err := s.User.GetUser(ctx, update.UserID)
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
 	if user == "" {
-		return nil, errors.New("user not found")
+		return nil, nil, apperrors.NotFound("user not found")
 	}
-	budget, err := s.BudgetRepo.GetBudget(ctx, update.UserID, update.BudgetID)
+	budget, err := s.budgetForRole(ctx, update.UserID, update.BudgetID, models.RoleEditor)
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
-	if budget == nil {
-		return nil, errors.New("budget is not found")
+	if err := checkVersion(budget, update.Version); err != nil {
+		return nil, nil, err
 	}
 	isExist := false
 	var existCategory models.Category 
@@ -358,30 +638,59 @@ func (s *BudgetService) UpdateCategory(ctx context.Context, update models.GetUpd
 		}
 	}
 	if !isExist {
-		return nil, errors.New("category is not found")
+		return nil, nil, apperrors.NotFound("category is not found")
 	}
 	updates := models.Category{ID: update.CategoryID}
 	if update.Name != nil {
 		updates.Name = *update.Name
+		for _, categ := range budget.Category {
+			// the version precondition of the update makes this check atomic
+			if categ.ID != update.CategoryID && categ.Name == updates.Name {
+				return nil, nil, apperrors.AlreadyExists("category with name %s is already added to this budget", updates.Name)
+			}
+		}
 	} else {
 		updates.Name = existCategory.Name
 	}
 	if update.Limit != nil {
 		updates.Limit = *update.Limit
+		if updates.Limit < 0 {
+			updates.Limit *= -1
+		}
 	} else {
 		updates.Limit = existCategory.Limit
 	}
-	err = s.BudgetRepo.UpdateCategory(ctx, update.UserID, update.BudgetID, updates)
-	if err != nil {
-		log.Println(err)
-		return nil, err
+	var warnings []string
+	if updates.Limit > existCategory.Limit {
+		warnings, err = checkCategoryAllocation(budget, existCategory.ID, updates.Limit)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
-	
-	budget, err = s.BudgetRepo.GetBudget(ctx, update.UserID, update.BudgetID)
-	if err != nil{
+	updates.Carried = existCategory.Carried
+	updates.Rollover = absRollover(mergeRollover(existCategory.Rollover, update.RolloverMode, update.RolloverCap))
+	if err := validateRollover(updates.Rollover); err != nil {
+		return nil, nil, err
+	}
+	var updated *models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.UpdateCategory(ctx, budget.UserID, update.BudgetID, updates, budget.Version); err != nil {
+			return nil, err
+		}
+		var err error
+		updated, err = s.BudgetRepo.GetBudget(ctx, update.UserID, update.BudgetID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, update.UserID, "UpdateCategory", budget, updated); err != nil {
+			return nil, err
+		}
+		return categoryEvents(models.EventCategoryUpdated, update.CategoryID, budget, updated), nil
+	})
+	if err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, nil, err
 	}
-	return budget, nil
+	return updated, warnings, nil
 
 }
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// CloneBudget copies the name, limits and categories of a budget the user has
+// access to into a new budget of the user. Without explicit dates the copy
+// starts where the budget ends and lasts as long. Carried amounts, the
+// recurrence and the members are not copied, and the categories get new IDs.
+func (s *BudgetService) CloneBudget(ctx context.Context, clone models.CloneBudget) (*models.Budget, []string, error) {
+	source, err := s.GetBudget(ctx, clone.UserID, clone.BudgetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	start, end, err := cloneDates(*source, clone.StartDate, clone.EndDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	if clone.Scale < 0 {
+		return nil, nil, apperrors.InvalidArgument("scale must not be negative")
+	}
+	scale := func(limit models.Money) (models.Money, error) {
+		if clone.Scale == 0 {
+			return limit, nil
+		}
+		scaled, err := clone.Scale.Of(limit)
+		if err != nil {
+			return 0, apperrors.InvalidArgument("scaled limit: %v", err)
+		}
+		return scaled, nil
+	}
+	limit, err := scale(source.Limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	budget := models.Budget{
+		UserID:     clone.UserID,
+		Name:       source.Name,
+		Limit:      limit,
+		Currency:   s.budgetCurrency(*source),
+		Allocation: source.Allocation,
+		StartDate:  start,
+		EndDate:    end,
+		Category:   make([]models.Category, 0, len(source.Category)),
+	}
+	for _, categ := range source.Category {
+		limit, err := scale(categ.Limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		budget.Category = append(budget.Category, models.Category{
+			Name:     categ.Name,
+			Limit:    limit,
+			Rollover: categ.Rollover,
+		})
+	}
+	warnings, err := checkBudgetAllocation(budget.Allocation, budget.Limit, budget.Category)
+	if err != nil {
+		return nil, nil, err
+	}
+	created, err := s.createBudget(ctx, "CloneBudget", budget)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, warnings, nil
+}
+
+func cloneDates(source models.Budget, startDate, endDate string) (time.Time, time.Time, error) {
+	if startDate == "" && endDate == "" {
+		return source.EndDate, source.EndDate.Add(source.EndDate.Sub(source.StartDate)), nil
+	}
+	if startDate == "" || endDate == "" {
+		return time.Time{}, time.Time{}, apperrors.InvalidArgument("either both start and end dates or neither are required")
+	}
+	start, err := parseDate(startDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := parseDate(endDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if !start.Before(end) {
+		start, end = end, start
+	}
+	return start, end, nil
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// csvHeader lists the columns of exported and imported budgets. Every row is
+// one category, a budget without categories has one row with the category
+// columns left empty.
+var csvHeader = []string{
+	"budget", "start", "end", "limit", "currency", "allocation",
+	"category", "category_limit", "rollover_mode", "rollover_cap",
+}
+
+// ExportBudgets writes the budgets the user owns as CSV, ordered by start
+// date.
+func (s *BudgetService) ExportBudgets(ctx context.Context, userID string, w io.Writer) error {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if user == "" {
+		return apperrors.NotFound("user not found")
+	}
+	budgets, err := s.BudgetRepo.GetBudgetList(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	sort.SliceStable(budgets, func(i, j int) bool {
+		return budgets[i].StartDate.Before(budgets[j].StartDate)
+	})
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, budget := range budgets {
+		row := []string{
+			budget.Name,
+			budget.StartDate.UTC().Format(time.RFC3339),
+			budget.EndDate.UTC().Format(time.RFC3339),
+			budget.Limit.String(),
+			s.budgetCurrency(budget),
+			budget.Allocation,
+		}
+		if len(budget.Category) == 0 {
+			if err := cw.Write(append(row, "", "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, categ := range budget.Category {
+			mode, cap := "", ""
+			if categ.Rollover != nil {
+				mode, cap = categ.Rollover.Mode, categ.Rollover.Cap.String()
+			}
+			if err := cw.Write(append(row, categ.Name, categ.Limit.String(), mode, cap)); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// importedBudget is a budget read from the rows of an import.
+type importedBudget struct {
+	row        int
+	budget     models.Budget
+	categories map[string]int
+}
+
+// ImportBudgets reads budgets in the format of ExportBudgets and adds them to
+// the budgets of the user, all of them or, when any row has a problem, none.
+// Dates are either RFC 3339 timestamps or dates like 2024-01-31.
+func (s *BudgetService) ImportBudgets(ctx context.Context, userID string, r io.Reader, dryRun bool) (*models.ImportResult, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	budgets, problems, err := s.readImport(userID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// the lock keeps the overlap check valid until the budgets are inserted
+	unlock, err := s.Locks.Lock(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer unlock()
+	existing, err := s.BudgetRepo.GetBudgetList(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	result := &models.ImportResult{Budgets: len(budgets)}
+	for i, imported := range budgets {
+		result.Categories += len(imported.budget.Category)
+		for _, other := range existing {
+			if doTasksOverlap(other, imported.budget) {
+				problems = append(problems, models.ImportProblem{
+					Row:     imported.row,
+					Kind:    models.ImportOverlap,
+					Message: fmt.Sprintf("budget %s overlaps with the existing budget %s", imported.budget.Name, other.Name),
+				})
+			}
+		}
+		for _, other := range budgets[:i] {
+			if doTasksOverlap(other.budget, imported.budget) {
+				problems = append(problems, models.ImportProblem{
+					Row:     imported.row,
+					Kind:    models.ImportOverlap,
+					Message: fmt.Sprintf("budget %s overlaps with budget %s in row %d", imported.budget.Name, other.budget.Name, other.row),
+				})
+			}
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Row < problems[j].Row
+	})
+	result.Problems = problems
+	if dryRun || len(problems) > 0 {
+		return result, nil
+	}
+
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		var events []models.Event
+		for _, imported := range budgets {
+			id, err := s.BudgetRepo.AddBudget(ctx, imported.budget)
+			if err != nil {
+				return nil, err
+			}
+			budget, err := s.BudgetRepo.GetBudget(ctx, userID, id)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, budgetEvents(models.EventBudgetCreated, nil, budget)...)
+			if err := s.audit(ctx, userID, "ImportBudgets", nil, budget); err != nil {
+				return nil, err
+			}
+		}
+		return events, nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	result.Committed = true
+	return result, nil
+}
+
+// readImport groups the rows into budgets by name and dates. Rows that can
+// not be read are reported as problems; a missing or unexpected header fails
+// the whole import.
+func (s *BudgetService) readImport(userID string, r io.Reader) ([]*importedBudget, []models.ImportProblem, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(csvHeader)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil, apperrors.InvalidArgument("csv is empty")
+	}
+	if err != nil && !errors.As(err, new(*csv.ParseError)) {
+		return nil, nil, err
+	}
+	if len(header) > 0 {
+		// spreadsheets may start the file with a byte order mark
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	if len(header) != len(csvHeader) || !equalFold(header, csvHeader) {
+		return nil, nil, apperrors.InvalidArgument("csv header must be: %s", strings.Join(csvHeader, ","))
+	}
+
+	var budgets []*importedBudget
+	var problems []models.ImportProblem
+	byKey := make(map[string]*importedBudget)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			problems = append(problems, models.ImportProblem{Row: parseErr.Line, Kind: models.ImportInvalidRow, Message: parseErr.Err.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		row, _ := cr.FieldPos(0)
+		budget, categ, err := s.parseImportRow(userID, record)
+		if err != nil {
+			problems = append(problems, models.ImportProblem{Row: row, Kind: models.ImportInvalidRow, Message: err.Error()})
+			continue
+		}
+
+		key := strings.Join([]string{budget.Name, budget.StartDate.String(), budget.EndDate.String()}, "\x00")
+		imported, ok := byKey[key]
+		if !ok {
+			imported = &importedBudget{row: row, budget: budget, categories: make(map[string]int)}
+			byKey[key] = imported
+			budgets = append(budgets, imported)
+		} else if imported.budget.Limit != budget.Limit || imported.budget.Currency != budget.Currency ||
+			imported.budget.Allocation != budget.Allocation {
+			problems = append(problems, models.ImportProblem{
+				Row:     row,
+				Kind:    models.ImportInvalidRow,
+				Message: fmt.Sprintf("limit, currency or allocation of budget %s differ from row %d", budget.Name, imported.row),
+			})
+			continue
+		}
+		if categ == nil {
+			continue
+		}
+		if first, ok := imported.categories[categ.Name]; ok {
+			problems = append(problems, models.ImportProblem{
+				Row:     row,
+				Kind:    models.ImportDuplicateCategory,
+				Message: fmt.Sprintf("category %s of budget %s is already in row %d", categ.Name, budget.Name, first),
+			})
+			continue
+		}
+		imported.categories[categ.Name] = row
+		imported.budget.Category = append(imported.budget.Category, *categ)
+	}
+
+	for _, imported := range budgets {
+		_, err := checkBudgetAllocation(imported.budget.Allocation, imported.budget.Limit, imported.budget.Category)
+		if err != nil {
+			problems = append(problems, models.ImportProblem{Row: imported.row, Kind: models.ImportInvalidRow, Message: err.Error()})
+		}
+	}
+	return budgets, problems, nil
+}
+
+// parseImportRow returns the budget of the row and its category, which is nil
+// when the category columns are empty.
+func (s *BudgetService) parseImportRow(userID string, record []string) (models.Budget, *models.Category, error) {
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+	name, categName := record[0], record[6]
+	if name == "" {
+		return models.Budget{}, nil, apperrors.InvalidArgument("budget name is required")
+	}
+	start, err := parseImportDate(record[1])
+	if err != nil {
+		return models.Budget{}, nil, err
+	}
+	end, err := parseImportDate(record[2])
+	if err != nil {
+		return models.Budget{}, nil, err
+	}
+	if !start.Before(end) {
+		return models.Budget{}, nil, apperrors.InvalidArgument("start must be before end")
+	}
+	limit, err := parseImportMoney("limit", record[3])
+	if err != nil {
+		return models.Budget{}, nil, err
+	}
+	currency, err := normalizeCurrency(record[4], s.DefaultCurrency)
+	if err != nil {
+		return models.Budget{}, nil, err
+	}
+	allocation := record[5]
+	if allocation == "" {
+		allocation = models.AllocationUnrestricted
+	}
+	if err := validateAllocation(allocation); err != nil {
+		return models.Budget{}, nil, err
+	}
+	budget := models.Budget{
+		UserID:     userID,
+		Name:       name,
+		Limit:      limit,
+		Currency:   currency,
+		Allocation: allocation,
+		StartDate:  start,
+		EndDate:    end,
+		Category:   []models.Category{},
+	}
+
+	if categName == "" {
+		if record[7] != "" || record[8] != "" || record[9] != "" {
+			return models.Budget{}, nil, apperrors.InvalidArgument("category name is required")
+		}
+		return budget, nil, nil
+	}
+	categLimit, err := parseImportMoney("category limit", record[7])
+	if err != nil {
+		return models.Budget{}, nil, err
+	}
+	categ := &models.Category{Name: categName, Limit: categLimit}
+	if record[8] != "" {
+		rolloverCap := models.Money(0)
+		if record[9] != "" {
+			rolloverCap, err = parseImportMoney("rollover cap", record[9])
+			if err != nil {
+				return models.Budget{}, nil, err
+			}
+		}
+		categ.Rollover = &models.Rollover{Mode: record[8], Cap: rolloverCap}
+		if err := validateRollover(categ.Rollover); err != nil {
+			return models.Budget{}, nil, err
+		}
+	}
+	return budget, categ, nil
+}
+
+func parseImportDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date.UTC(), nil
+	}
+	date, err := time.Parse(Dateformat, value)
+	if err != nil {
+		return time.Time{}, apperrors.InvalidArgument("invalid date %q, expected format %s or RFC 3339", value, Dateformat)
+	}
+	return date, nil
+}
+
+// parseImportMoney rejects negative amounts, which the other requests turn
+// positive, since in a file they are more likely a mistake.
+func parseImportMoney(field, value string) (models.Money, error) {
+	amount, err := models.ParseMoney(value)
+	if err != nil {
+		return 0, apperrors.InvalidArgument("invalid %s: %v", field, err)
+	}
+	if amount < 0 {
+		return 0, apperrors.InvalidArgument("%s must not be negative", field)
+	}
+	return amount, nil
+}
+
+func equalFold(a, b []string) bool {
+	for i := range a {
+		if !strings.EqualFold(strings.TrimSpace(a[i]), b[i]) {
+			return false
+		}
+	}
+	return true
+}
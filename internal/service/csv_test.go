@@ -0,0 +1,155 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+const importHeader = "budget,start,end,limit,currency,allocation,category,category_limit,rollover_mode,rollover_cap\n"
+
+func TestImportBudgets(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []models.CreateBudget
+		csv      string
+		dryRun   bool
+		want     models.ImportResult
+		problems []string
+		names    []string
+	}{
+		{
+			name: "clean import",
+			csv: importHeader +
+				"food,2025-01-01,2025-02-01,100.50,EUR,strict,groceries,60,surplus,10\n" +
+				"food,2025-01-01,2025-02-01,100.50,EUR,strict,restaurants,40.50,,\n" +
+				"rent,2025-02-01,2025-03-01,900,,,,,,\n",
+			want:  models.ImportResult{Budgets: 2, Categories: 2, Committed: true},
+			names: []string{"food", "rent"},
+		},
+		{
+			name: "dry run",
+			csv: importHeader +
+				"food,2025-01-01,2025-02-01,100,,,groceries,60,,\n" +
+				"rent,2025-02-01,2025-03-01,900,,,,,,\n",
+			dryRun: true,
+			want:   models.ImportResult{Budgets: 2, Categories: 1},
+		},
+		{
+			name: "invalid rows",
+			csv: importHeader +
+				"food,2025-01-01,2025-02-01,100,,,groceries,60,,\n" +
+				"food,2025-01-01,2025-02-01,100,,,groceries,20,,\n" +
+				"food,2025-01-01,2025-02-01,200,,,restaurants,20,,\n" +
+				"rent,2025-13-01,2025-03-01,900,,,,,,\n" +
+				"fuel,2025-03-01,2025-04-01,100,JPY,,,,,\n" +
+				"car,1,2\n",
+			want: models.ImportResult{Budgets: 1, Categories: 1},
+			problems: []string{
+				models.ImportDuplicateCategory, models.ImportInvalidRow, models.ImportInvalidRow,
+				models.ImportInvalidRow, models.ImportInvalidRow,
+			},
+		},
+		{
+			name: "overlap within the file",
+			csv: importHeader +
+				"food,2025-01-01,2025-02-01,100,,,,,,\n" +
+				"rent,2025-01-15,2025-02-15,900,,,,,,\n",
+			want:     models.ImportResult{Budgets: 2},
+			problems: []string{models.ImportOverlap},
+		},
+		{
+			name:     "overlap with an existing budget",
+			existing: []models.CreateBudget{{UserID: "u1", Name: "old", Limit: 100, StartDate: "2025-01-20", EndDate: "2025-02-20"}},
+			csv: importHeader +
+				"food,2025-01-01,2025-02-01,100,,,,,,\n" +
+				"rent,2025-03-01,2025-04-01,900,,,,,,\n",
+			want:     models.ImportResult{Budgets: 2},
+			problems: []string{models.ImportOverlap},
+			names:    []string{"old"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, repos := newTestService()
+			for _, budget := range tt.existing {
+				mustAddBudget(t, s, budget)
+			}
+			// claiming leases the events of the existing budgets
+			pendingEvents(t, repos)
+
+			result, err := s.ImportBudgets(ctx, "u1", strings.NewReader(tt.csv), tt.dryRun)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var kinds []string
+			for _, problem := range result.Problems {
+				kinds = append(kinds, problem.Kind)
+			}
+			if !slices.Equal(kinds, tt.problems) {
+				t.Fatalf("problems %+v, want kinds %v", result.Problems, tt.problems)
+			}
+			result.Problems = nil
+			if !reflect.DeepEqual(*result, tt.want) {
+				t.Fatalf("result %+v, want %+v", *result, tt.want)
+			}
+			names := budgetNames(t, repos, "u1")
+			slices.Sort(names)
+			if !slices.Equal(names, tt.names) {
+				t.Fatalf("stored budgets %v, want %v", names, tt.names)
+			}
+			if events := pendingEvents(t, repos); (events != 0) != tt.want.Committed {
+				t.Fatalf("%d events in the outbox, committed %v", events, tt.want.Committed)
+			}
+		})
+	}
+}
+
+func TestImportBudgetsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService()
+	_, err := s.ImportBudgets(ctx, "u1", strings.NewReader(importHeader+
+		"food,2025-01-01,2025-02-01,100.50,EUR,strict,groceries,60,surplus,10\n"+
+		"food,2025-01-01,2025-02-01,100.50,EUR,strict,restaurants,40.50,,\n"+
+		"rent,2025-02-01,2025-03-01,900,,,,,,\n"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported strings.Builder
+	if err := s.ExportBudgets(ctx, "u1", &exported); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, _ := newTestService()
+	result, err := restored.ImportBudgets(ctx, "u1", strings.NewReader(exported.String()), false)
+	if err != nil || !result.Committed {
+		t.Fatalf("import of the export: %+v, %v", result, err)
+	}
+	var again strings.Builder
+	if err := restored.ExportBudgets(ctx, "u1", &again); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != exported.String() {
+		t.Fatalf("export after import:\n%s\nwant:\n%s", again.String(), exported.String())
+	}
+}
+
+func TestImportBudgetsHeader(t *testing.T) {
+	for _, csv := range []string{"", "budget,start,end\n", "name,start,end,limit,currency,allocation,category,category_limit,rollover_mode,rollover_cap\n"} {
+		s, repos := newTestService()
+		_, err := s.ImportBudgets(context.Background(), "u1", strings.NewReader(csv), false)
+		if !errors.Is(err, apperrors.ErrInvalidArgument) {
+			t.Fatalf("import of %q: %v, want an invalid argument", csv, err)
+		}
+		if names := budgetNames(t, repos, "u1"); len(names) != 0 {
+			t.Fatalf("stored budgets %v", names)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// ExchangeRateProvider returns the price of one unit of from expressed in to,
+// valid on date, and the date the rate was actually published for.
+type ExchangeRateProvider interface {
+	Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, time.Time, error)
+}
+
+// normalizeCurrency upper-cases code and falls back to def when it is empty.
+// Currencies whose minor unit is not a cent are rejected.
+func normalizeCurrency(code, def string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		code = def
+	}
+	if !models.IsCurrency(code) {
+		return "", apperrors.InvalidArgument("invalid currency: %s", code)
+	}
+	if !models.HasCents(code) {
+		return "", apperrors.InvalidArgument("currency %s is not supported, amounts have two decimal places", code)
+	}
+	return code, nil
+}
+
+// budgetCurrency treats budgets created before currencies were introduced as
+// being in the default currency.
+func (s *BudgetService) budgetCurrency(budget models.Budget) string {
+	if budget.Currency == "" {
+		return s.DefaultCurrency
+	}
+	return budget.Currency
+}
+
+// formatRate prints rate as a decimal with up to 10 fractional digits.
+func formatRate(rate *big.Rat) string {
+	value := rate.FloatString(10)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".")
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+type ExpenseRepository interface {
+	AddExpense(ctx context.Context, expense models.Expense) (string, error)
+	GetExpense(ctx context.Context, expenseID string) (*models.Expense, error)
+	GetExpenses(ctx context.Context, userID, budgetID, categoryID string) ([]models.Expense, error)
+	DeleteExpense(ctx context.Context, userID, expenseID string) error
+	DeleteBudgetExpenses(ctx context.Context, budgetIDs []string) error
+}
+
+func (s *BudgetService) AddExpense(ctx context.Context, expense models.CreateExpense) (string, error) {
+	user, _, err := s.User.GetUser(ctx, expense.UserID)
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	if user == "" {
+		return "", apperrors.NotFound("user not found")
+	}
+	budget, err := s.budgetForRole(ctx, expense.UserID, expense.BudgetID, models.RoleEditor)
+	if err != nil {
+		return "", err
+	}
+	if !hasCategory(budget.Category, expense.CategoryID) {
+		return "", apperrors.NotFound("category is not found")
+	}
+	date := time.Now().UTC()
+	if expense.Date != "" {
+		date, err = parseDate(expense.Date)
+		if err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+	if !isWithinBudget(*budget, date) {
+		return "", apperrors.InvalidArgument("expense date is outside of the budget period")
+	}
+	if expense.Amount < 0 {
+		expense.Amount *= -1
+	}
+	newExpense := models.Expense{
+		UserID:     budget.UserID,
+		BudgetID:   expense.BudgetID,
+		CategoryID: expense.CategoryID,
+		Amount:     expense.Amount,
+		Currency:   s.budgetCurrency(*budget),
+		Date:       date,
+		Note:       expense.Note,
+	}
+	if expense.UserID != budget.UserID {
+		newExpense.CreatedBy = expense.UserID
+	}
+	currency, err := normalizeCurrency(expense.Currency, newExpense.Currency)
+	if err != nil {
+		return "", err
+	}
+	if currency != newExpense.Currency {
+		rate, rateDate, err := s.Rates.Rate(ctx, currency, newExpense.Currency, date)
+		if err != nil {
+			log.Println(err)
+			return "", err
+		}
+		newExpense.Original = &models.ForeignAmount{
+			Amount:   expense.Amount,
+			Currency: currency,
+			Rate:     formatRate(rate),
+			RateDate: rateDate,
+		}
+		newExpense.Amount, err = expense.Amount.Convert(rate)
+		if err != nil {
+			return "", apperrors.InvalidArgument("converted amount: %v", err)
+		}
+	}
+	var id string
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		spent, err := s.ExpenseRepo.GetExpenses(ctx, budget.UserID, expense.BudgetID, "")
+		if err != nil {
+			return nil, err
+		}
+		id, err = s.ExpenseRepo.AddExpense(ctx, newExpense)
+		if err != nil {
+			return nil, err
+		}
+		added := newExpense
+		added.ID = id
+		if err := s.auditExpense(ctx, expense.UserID, "AddExpense", nil, &added); err != nil {
+			return nil, err
+		}
+		return thresholdEvents(*budget, spent, newExpense), nil
+	})
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	return id, nil
+}
+
+// spendingThresholds are the percentages of a limit whose crossing is
+// announced with a ThresholdCrossed event.
+var spendingThresholds = []int{80, 100}
+
+// thresholdEvents describes the thresholds of the budget and the expense's
+// category that the expense makes spending cross, given the expenses
+// recorded before it.
+func thresholdEvents(budget models.Budget, expenses []models.Expense, expense models.Expense) []models.Event {
+	var budgetSpent, categSpent models.Money
+	for _, recorded := range expenses {
+		budgetSpent += recorded.Amount
+		if recorded.CategoryID == expense.CategoryID {
+			categSpent += recorded.Amount
+		}
+	}
+	events := []models.Event{}
+	add := func(categoryID, field string, spent, limit models.Money) {
+		for _, threshold := range crossedThresholds(spent, spent+expense.Amount, limit) {
+			event := newEvent(models.EventThresholdCrossed, categoryID, &budget, &budget, []models.FieldChange{{
+				Field:  field,
+				Before: spent.String(),
+				After:  (spent + expense.Amount).String(),
+			}})
+			event.Threshold = threshold
+			events = append(events, event)
+		}
+	}
+	add("", "spent", budgetSpent, budget.Limit)
+	for _, categ := range budget.Category {
+		if categ.ID == expense.CategoryID {
+			add(categ.ID, "categories["+categ.ID+"].spent", categSpent, categ.EffectiveLimit())
+		}
+	}
+	return events
+}
+
+func crossedThresholds(from, to, limit models.Money) []int {
+	crossed := []int{}
+	if limit <= 0 {
+		return crossed
+	}
+	for _, threshold := range spendingThresholds {
+		mark := int64(limit) * int64(threshold)
+		if int64(from)*100 < mark && int64(to)*100 >= mark {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
+
+func hasCategory(categs []models.Category, catID string) bool {
+	for _, categ := range categs {
+		if categ.ID == catID {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithinBudget compares at day granularity, since period budgets start at
+// the moment they were created while expense dates carry no time of day.
+func isWithinBudget(budget models.Budget, date time.Time) bool {
+	start := budget.StartDate.Truncate(24 * time.Hour)
+	return !date.Before(start) && !date.After(budget.EndDate)
+}
+
+func (s *BudgetService) ListExpenses(ctx context.Context, userID, budgetID, categoryID string) ([]models.Expense, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	budget, err := s.budgetForRole(ctx, userID, budgetID, models.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	if categoryID != "" && !hasCategory(budget.Category, categoryID) {
+		return nil, apperrors.NotFound("category is not found")
+	}
+	expenses, err := s.ExpenseRepo.GetExpenses(ctx, budget.UserID, budgetID, categoryID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return expenses, nil
+}
+
+func (s *BudgetService) DeleteExpense(ctx context.Context, userID, expenseID string) error {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if user == "" {
+		return apperrors.NotFound("user not found")
+	}
+	expense, err := s.ExpenseRepo.GetExpense(ctx, expenseID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if expense == nil {
+		return apperrors.NotFound("expense is not found")
+	}
+	// editors may delete the expenses of a shared budget, the owner's ones
+	// even once the budget is gone
+	if expense.UserID != userID {
+		budget, err := s.BudgetRepo.GetBudget(ctx, userID, expense.BudgetID)
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		if budget == nil || budget.UserID != expense.UserID {
+			return apperrors.NotFound("expense is not found")
+		}
+		if err := requireRole(budget, userID, models.RoleEditor); err != nil {
+			return err
+		}
+	}
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.ExpenseRepo.DeleteExpense(ctx, expense.UserID, expenseID); err != nil {
+			return nil, err
+		}
+		return nil, s.auditExpense(ctx, userID, "DeleteExpense", expense, nil)
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
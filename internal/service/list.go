@@ -0,0 +1,155 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// pageToken is handed out to clients, opaque, as base64 encoded JSON.
+type pageToken struct {
+	OrderBy    string              `json:"o"`
+	Descending bool                `json:"d"`
+	Filter     pageFilter          `json:"f"`
+	Cursor     models.BudgetCursor `json:"c"`
+}
+
+// pageFilter holds the filters of the listing a page token continues.
+type pageFilter struct {
+	ActiveAt   string `json:"a,omitempty"`
+	From       string `json:"f,omitempty"`
+	To         string `json:"t,omitempty"`
+	NamePrefix string `json:"n,omitempty"`
+	Category   string `json:"c,omitempty"`
+}
+
+func filterOf(list models.ListBudgets) pageFilter {
+	return pageFilter{
+		ActiveAt:   list.ActiveAt,
+		From:       list.From,
+		To:         list.To,
+		NamePrefix: list.NamePrefix,
+		Category:   list.Category,
+	}
+}
+
+func newBudgetQuery(list models.ListBudgets) (models.BudgetQuery, error) {
+	query := models.BudgetQuery{
+		UserID:     list.UserID,
+		NamePrefix: list.NamePrefix,
+		Category:   list.Category,
+		OrderBy:    list.OrderBy,
+		Descending: list.Descending,
+		Limit:      list.PageSize,
+	}
+	switch query.OrderBy {
+	case "":
+		query.OrderBy = models.BudgetOrderStart
+	case models.BudgetOrderStart, models.BudgetOrderName, models.BudgetOrderLimit:
+	default:
+		return query, apperrors.InvalidArgument("invalid order: %s", list.OrderBy)
+	}
+	// without a page size or token the whole list is returned, as it was
+	// before paging
+	if query.Limit <= 0 && list.PageToken != "" {
+		query.Limit = defaultPageSize
+	}
+	query.Limit = min(query.Limit, maxPageSize)
+
+	// Dates are whole days: a budget is active on a day if it overlaps any
+	// part of it.
+	if list.ActiveAt != "" {
+		day, err := parseDate(list.ActiveAt)
+		if err != nil {
+			return query, err
+		}
+		query.StartsBefore = startsBefore(query.StartsBefore, day.AddDate(0, 0, 1))
+		query.EndsNotBefore = endsNotBefore(query.EndsNotBefore, day)
+	}
+	var from time.Time
+	if list.From != "" {
+		var err error
+		from, err = parseDate(list.From)
+		if err != nil {
+			return query, err
+		}
+		query.EndsNotBefore = endsNotBefore(query.EndsNotBefore, from)
+	}
+	if list.To != "" {
+		to, err := parseDate(list.To)
+		if err != nil {
+			return query, err
+		}
+		if to.Before(from) {
+			return query, apperrors.InvalidArgument("from must not be after to")
+		}
+		query.StartsBefore = startsBefore(query.StartsBefore, to.AddDate(0, 0, 1))
+	}
+
+	if list.PageToken != "" {
+		token, err := decodePageToken(list.PageToken)
+		if err != nil {
+			return query, err
+		}
+		if token.OrderBy != query.OrderBy || token.Descending != query.Descending {
+			return query, apperrors.InvalidArgument("page token does not match the requested order")
+		}
+		if token.Filter != filterOf(list) {
+			return query, apperrors.InvalidArgument("page token does not match the requested filters")
+		}
+		query.After = &token.Cursor
+	}
+	return query, nil
+}
+
+func startsBefore(current *time.Time, t time.Time) *time.Time {
+	if current != nil && current.Before(t) {
+		return current
+	}
+	return &t
+}
+
+func endsNotBefore(current *time.Time, t time.Time) *time.Time {
+	if current != nil && current.After(t) {
+		return current
+	}
+	return &t
+}
+
+func encodePageToken(list models.ListBudgets, query models.BudgetQuery, last models.Budget) (string, error) {
+	data, err := json.Marshal(pageToken{
+		OrderBy:    query.OrderBy,
+		Descending: query.Descending,
+		Filter:     filterOf(list),
+		Cursor: models.BudgetCursor{
+			ID:    last.ID,
+			Start: last.StartDate,
+			Name:  last.Name,
+			Limit: last.Limit,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(value string) (pageToken, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return token, apperrors.InvalidArgument("invalid page token")
+	}
+	if err := json.Unmarshal(data, &token); err != nil || token.Cursor.ID == "" {
+		return token, apperrors.InvalidArgument("invalid page token")
+	}
+	return token, nil
+}
@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+// addDailyBudgets adds n one-day budgets named b000, b001, ... starting on
+// 2024-01-01.
+func addDailyBudgets(t *testing.T, s *service.BudgetService, n int) []string {
+	t.Helper()
+	first := date("2024-01-01")
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("b%03d", i)
+		mustAddBudget(t, s, models.CreateBudget{
+			UserID:    "u1",
+			Name:      names[i],
+			Limit:     100,
+			StartDate: first.AddDate(0, 0, i).Format(time.DateOnly),
+			EndDate:   first.AddDate(0, 0, i+1).Format(time.DateOnly),
+		})
+	}
+	return names
+}
+
+func listNames(budgets []models.Budget) []string {
+	names := make([]string, len(budgets))
+	for i, budget := range budgets {
+		names[i] = budget.Name
+	}
+	return names
+}
+
+func TestGetBudgetListPageSize(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService()
+	all := addDailyBudgets(t, s, 230)
+
+	tests := []struct {
+		name     string
+		pageSize int
+		want     int
+		next     bool
+	}{
+		{"no page size returns all budgets", 0, 230, false},
+		{"page size", 20, 20, true},
+		{"page size above the maximum", 1000, 200, true},
+		{"maximum page size", 200, 200, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budgets, next, err := s.GetBudgetList(ctx, models.ListBudgets{UserID: "u1", PageSize: tt.pageSize})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(listNames(budgets), all[:tt.want]) {
+				t.Fatalf("got %d budgets, want the first %d", len(budgets), tt.want)
+			}
+			if (next != "") != tt.next {
+				t.Fatalf("next page token %q, want one %v", next, tt.next)
+			}
+		})
+	}
+
+	// a token without a page size continues with the default page size
+	_, next, err := s.GetBudgetList(ctx, models.ListBudgets{UserID: "u1", PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	budgets, _, err := s.GetBudgetList(ctx, models.ListBudgets{UserID: "u1", PageToken: next})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(listNames(budgets), all[10:60]) {
+		t.Fatalf("got %v, want budgets 10 to 59", listNames(budgets))
+	}
+}
+
+func TestGetBudgetListPages(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService()
+	all := addDailyBudgets(t, s, 25)
+
+	tests := []struct {
+		name string
+		list models.ListBudgets
+		want []string
+	}{
+		{"all", models.ListBudgets{}, all},
+		{"descending", models.ListBudgets{Descending: true}, reversed(all)},
+		{"filtered", models.ListBudgets{From: "2024-01-05", To: "2024-01-20", NamePrefix: "b01"}, all[10:20]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := tt.list
+			list.UserID = "u1"
+			list.PageSize = 3
+			var got []string
+			for {
+				budgets, next, err := s.GetBudgetList(ctx, list)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, listNames(budgets)...)
+				if next == "" {
+					break
+				}
+				list.PageToken = next
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("pages %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func reversed(names []string) []string {
+	names = slices.Clone(names)
+	slices.Reverse(names)
+	return names
+}
+
+func TestGetBudgetListTokenMismatch(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService()
+	addDailyBudgets(t, s, 10)
+	first := models.ListBudgets{UserID: "u1", NamePrefix: "b0", From: "2024-01-02", PageSize: 2}
+	_, next, err := s.GetBudgetList(ctx, first)
+	if err != nil || next == "" {
+		t.Fatalf("first page: %q, %v", next, err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(list *models.ListBudgets)
+		err    error
+	}{
+		{"same listing", func(list *models.ListBudgets) {}, nil},
+		{"other page size", func(list *models.ListBudgets) { list.PageSize = 5 }, nil},
+		{"other name prefix", func(list *models.ListBudgets) { list.NamePrefix = "b" }, apperrors.ErrInvalidArgument},
+		{"filter dropped", func(list *models.ListBudgets) { list.From = "" }, apperrors.ErrInvalidArgument},
+		{"filter added", func(list *models.ListBudgets) { list.Category = "food" }, apperrors.ErrInvalidArgument},
+		{"other active date", func(list *models.ListBudgets) { list.ActiveAt = "2024-01-05" }, apperrors.ErrInvalidArgument},
+		{"other order", func(list *models.ListBudgets) { list.OrderBy = models.BudgetOrderName }, apperrors.ErrInvalidArgument},
+		{"other direction", func(list *models.ListBudgets) { list.Descending = true }, apperrors.ErrInvalidArgument},
+		{"invalid token", func(list *models.ListBudgets) { list.PageToken = "garbage" }, apperrors.ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := first
+			list.PageToken = next
+			tt.change(&list)
+			_, _, err := s.GetBudgetList(ctx, list)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("GetBudgetList() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
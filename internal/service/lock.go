@@ -0,0 +1,11 @@
+package service
+
+import "context"
+
+// Locker serializes operations that must see a consistent view of several
+// budgets, such as the overlap check before creating one. Keys are user IDs.
+type Locker interface {
+	// Lock blocks until the key is acquired or ctx is done. The returned
+	// function releases the key.
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// Transactor runs fn so that the writes it makes with the given context are
+// applied together or not at all.
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type OutboxRepository interface {
+	AddEvents(ctx context.Context, events []models.Event) error
+	// ClaimEvents returns up to limit events that are due at now, counts the
+	// attempt and hides them from other claims until the lease expires.
+	ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Event, error)
+	// DeleteEvent removes a published event.
+	DeleteEvent(ctx context.Context, id string) error
+	// FailEvent schedules the next attempt of an event or, with deadLetter,
+	// stops retrying it.
+	FailEvent(ctx context.Context, id string, next time.Time, lastErr string, deadLetter bool) error
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event models.Event) error
+}
+
+// withEvents runs change in a transaction together with writing the events
+// it returns to the outbox, so events are only published for applied changes.
+func (s *BudgetService) withEvents(ctx context.Context, change func(ctx context.Context) ([]models.Event, error)) error {
+	return s.Tx.WithTransaction(ctx, func(ctx context.Context) error {
+		events, err := change(ctx)
+		if err != nil {
+			return err
+		}
+		return s.Outbox.AddEvents(ctx, events)
+	})
+}
+
+// budgetEvents describes a change of a budget from before to after, either
+// of which is nil when the budget was created or removed. A changed limit is
+// also announced with a LimitChanged event.
+func budgetEvents(eventType string, before, after *models.Budget) []models.Event {
+	changes := diffBudgets(before, after)
+	events := []models.Event{newEvent(eventType, "", before, after, changes)}
+	if before != nil && after != nil && before.Limit != after.Limit {
+		events = append(events, newEvent(models.EventLimitChanged, "", before, after, selectChanges(changes, "limit")))
+	}
+	return events
+}
+
+// categoryEvents describes a change of one category of a budget.
+func categoryEvents(eventType, categoryID string, before, after *models.Budget) []models.Event {
+	prefix := "categories[" + categoryID + "]."
+	changes := selectChanges(diffBudgets(before, after), prefix)
+	events := []models.Event{newEvent(eventType, categoryID, before, after, changes)}
+	if limit := selectChanges(changes, prefix+"limit"); len(limit) > 0 && eventType == models.EventCategoryUpdated {
+		events = append(events, newEvent(models.EventLimitChanged, categoryID, before, after, limit))
+	}
+	return events
+}
+
+func newEvent(eventType, categoryID string, before, after *models.Budget, changes []models.FieldChange) models.Event {
+	budget := after
+	if budget == nil {
+		budget = before
+	}
+	now := time.Now().UTC()
+	return models.Event{
+		Type:        eventType,
+		UserID:      budget.UserID,
+		BudgetID:    budget.ID,
+		Members:     memberIDs(before, after),
+		CategoryID:  categoryID,
+		OccurredAt:  now,
+		Changes:     changes,
+		NextAttempt: now,
+	}
+}
+
+// selectChanges keeps the changes of the field or, for a prefix ending with
+// a dot, of all fields below it.
+func selectChanges(changes []models.FieldChange, field string) []models.FieldChange {
+	selected := []models.FieldChange{}
+	for _, change := range changes {
+		if change.Field == field || strings.HasSuffix(field, ".") && strings.HasPrefix(change.Field, field) {
+			selected = append(selected, change)
+		}
+	}
+	return selected
+}
+
+const (
+	// relayLease is how long a claimed event is hidden from other relays. A
+	// relay that crashes while publishing leaves its events to the next claim.
+	relayLease     = time.Minute
+	publishTimeout = 10 * time.Second
+	maxBackoff     = time.Hour
+)
+
+// OutboxRelay publishes the events of the outbox at least once. Failed
+// events are retried with a doubling backoff and dead-lettered after
+// maxAttempts.
+type OutboxRelay struct {
+	outbox      OutboxRepository
+	publisher   Publisher
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+}
+
+func NewOutboxRelay(outbox OutboxRepository, publisher Publisher, batchSize, maxAttempts int, backoff time.Duration) *OutboxRelay {
+	return &OutboxRelay{outbox: outbox, publisher: publisher, batchSize: batchSize, maxAttempts: maxAttempts, backoff: backoff}
+}
+
+// Run relays due events every interval until ctx is done.
+func (r *OutboxRelay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.RelayDueEvents(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			log.Printf("event relay failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayDueEvents publishes the events due at now, batch by batch until the
+// outbox has none left.
+func (r *OutboxRelay) RelayDueEvents(ctx context.Context, now time.Time) error {
+	for {
+		events, err := r.outbox.ClaimEvents(ctx, now, relayLease, r.batchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := r.relay(ctx, event, now); err != nil {
+				return err
+			}
+		}
+		if len(events) < r.batchSize {
+			return nil
+		}
+	}
+}
+
+// relay publishes a claimed event and records the outcome. Only failures to
+// update the outbox are returned, the claim makes sure the event is retried.
+func (r *OutboxRelay) relay(ctx context.Context, event models.Event, now time.Time) error {
+	publishCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	err := r.publisher.Publish(publishCtx, event)
+	cancel()
+	if err == nil {
+		return r.outbox.DeleteEvent(ctx, event.ID)
+	}
+	if event.Attempts >= r.maxAttempts {
+		log.Printf("dead-lettering event %s %s after %d attempts: %v", event.ID, event.Type, event.Attempts, err)
+		return r.outbox.FailEvent(ctx, event.ID, now, err.Error(), true)
+	}
+	return r.outbox.FailEvent(ctx, event.ID, now.Add(r.retryDelay(event.Attempts)), err.Error(), false)
+}
+
+// retryDelay is the backoff after the given number of failed attempts.
+func (r *OutboxRelay) retryDelay(attempts int) time.Duration {
+	delay := r.backoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// newID returns a random ID in the hex form of MongoDB object IDs.
+func newID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *BudgetService) ListBudgetSeries(ctx context.Context, userID, seriesID string) ([]models.Budget, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	budgets, err := s.BudgetRepo.GetBudgetSeries(ctx, userID, seriesID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if len(budgets) == 0 {
+		return nil, apperrors.NotFound("budget series is not found")
+	}
+	return budgets, nil
+}
+
+func (s *BudgetService) StopRecurrence(ctx context.Context, userID, budgetID string, version *int64) (*models.Budget, error) {
+	budget, err := s.GetBudget(ctx, userID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireRole(budget, userID, models.RoleOwner); err != nil {
+		return nil, err
+	}
+	// stopping is idempotent, so the version is only checked up front
+	if err := checkVersion(budget, version); err != nil {
+		return nil, err
+	}
+	if budget.Recurrence == nil {
+		return nil, apperrors.FailedPrecondition("budget is not recurring")
+	}
+	err = s.stopSeries(ctx, userID, "StopRecurrence", userID, budget.SeriesID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	budget, err = s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return budget, nil
+}
+
+// RunRenewal renews ended recurring budgets every interval until ctx is done.
+func (s *BudgetService) RunRenewal(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.RenewDueBudgets(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			log.Printf("budget renewal failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RenewDueBudgets creates the next instance of every active recurring budget
+// that ended before now. It keeps going until the series have caught up, so
+// a series whose renewal was missed for several periods gets all of them.
+func (s *BudgetService) RenewDueBudgets(ctx context.Context, now time.Time) error {
+	for {
+		due, err := s.BudgetRepo.GetDueRecurringBudgets(ctx, now)
+		if err != nil {
+			return err
+		}
+		renewed := 0
+		for _, budget := range due {
+			if err := s.renewBudget(ctx, budget); err != nil {
+				log.Printf("failed to renew budget %s: %v", budget.ID, err)
+				continue
+			}
+			renewed++
+		}
+		if renewed == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *BudgetService) renewBudget(ctx context.Context, budget models.Budget) error {
+	expenses, err := s.ExpenseRepo.GetExpenses(ctx, budget.UserID, budget.ID, "")
+	if err != nil {
+		return err
+	}
+	spent := make(map[string]models.Money, len(budget.Category))
+	for _, expense := range expenses {
+		spent[expense.CategoryID] += expense.Amount
+	}
+	next := nextInstance(budget, spent)
+	unlock, err := s.Locks.Lock(ctx, budget.UserID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	budgets, err := s.BudgetRepo.GetBudgetList(ctx, budget.UserID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range budgets {
+		if existing.ID != budget.ID && doTasksOverlap(existing, next) {
+			log.Printf("stopping recurrence of series %s: next period overlaps with budget %s", budget.SeriesID, existing.ID)
+			return s.stopSeries(ctx, systemActor, "RenewBudget", budget.UserID, budget.SeriesID)
+		}
+	}
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		id, err := s.BudgetRepo.RenewBudget(ctx, budget.ID, next)
+		if err != nil {
+			return nil, err
+		}
+		// read back for the category IDs assigned by the repository
+		created, err := s.BudgetRepo.GetBudget(ctx, budget.UserID, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, systemActor, "RenewBudget", nil, created); err != nil {
+			return nil, err
+		}
+		return budgetEvents(models.EventBudgetRenewed, nil, created), nil
+	})
+	if errors.Is(err, apperrors.ErrConflict) {
+		// another worker renewed it first
+		return nil
+	}
+	return err
+}
+
+// stopSeries stops the recurrence of every budget in the series and audits
+// the budgets that changed.
+func (s *BudgetService) stopSeries(ctx context.Context, actor, operation, userID, seriesID string) error {
+	before, err := s.BudgetRepo.GetBudgetSeries(ctx, userID, seriesID)
+	if err != nil {
+		return err
+	}
+	var after []models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.StopRecurrence(ctx, userID, seriesID); err != nil {
+			return nil, err
+		}
+		var err error
+		after, err = s.BudgetRepo.GetBudgetSeries(ctx, userID, seriesID)
+		if err != nil {
+			return nil, err
+		}
+		events := []models.Event{}
+		for i := range after {
+			for j := range before {
+				if before[j].ID == after[i].ID {
+					events = append(events, budgetEvents(models.EventRecurrenceStopped, &before[j], &after[i])...)
+					if err := s.audit(ctx, actor, operation, &before[j], &after[i]); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		return events, nil
+	})
+	return err
+}
+
+// nextInstance copies the budget into the following period, carrying over
+// what is left of each category according to its rollover settings. Category
+// IDs are left empty so the repository assigns fresh ones.
+func nextInstance(budget models.Budget, spent map[string]models.Money) models.Budget {
+	next := models.Budget{
+		UserID:     budget.UserID,
+		Name:       budget.Name,
+		Limit:      budget.Limit,
+		Currency:   budget.Currency,
+		Allocation: budget.Allocation,
+		StartDate:  budget.EndDate,
+		EndDate:    addPeriod(budget.EndDate, budget.Recurrence.Period),
+		Category:   make([]models.Category, 0, len(budget.Category)),
+		Recurrence: &models.Recurrence{Period: budget.Recurrence.Period, Active: true},
+		SeriesID:   budget.SeriesID,
+		Members:    budget.Members,
+	}
+	for _, categ := range budget.Category {
+		next.Category = append(next.Category, models.Category{
+			Name:     categ.Name,
+			Limit:    categ.Limit,
+			Carried:  carryOver(categ, spent[categ.ID]),
+			Rollover: categ.Rollover,
+		})
+	}
+	return next
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func validateRollover(rollover *models.Rollover) error {
+	if rollover == nil {
+		return nil
+	}
+	switch rollover.Mode {
+	case models.RolloverNone, models.RolloverSurplus, models.RolloverDebt, models.RolloverBoth:
+	default:
+		return apperrors.InvalidArgument("invalid rollover mode %q, expected one of: none, surplus, debt, both", rollover.Mode)
+	}
+	if rollover.Cap < 0 {
+		return apperrors.InvalidArgument("rollover cap must not be negative")
+	}
+	return nil
+}
+
+// absRollover returns rollover with a positive cap, the sign of a cap is
+// ignored like the sign of a limit. rollover itself is left unchanged.
+func absRollover(rollover *models.Rollover) *models.Rollover {
+	if rollover == nil || rollover.Cap >= 0 {
+		return rollover
+	}
+	abs := *rollover
+	abs.Cap = abs.Cap.Abs()
+	return &abs
+}
+
+func mergeRollover(existing *models.Rollover, mode *string, cap *models.Money) *models.Rollover {
+	if mode == nil && cap == nil {
+		return existing
+	}
+	merged := models.Rollover{Mode: models.RolloverNone}
+	if existing != nil {
+		merged = *existing
+	}
+	if mode != nil {
+		merged.Mode = *mode
+	}
+	if cap != nil {
+		merged.Cap = *cap
+	}
+	return &merged
+}
+
+// carryOver returns the amount the category passes on to its next period
+// given what was spent in the current one.
+func carryOver(categ models.Category, spent models.Money) models.Money {
+	if categ.Rollover == nil {
+		return 0
+	}
+	left := categ.EffectiveLimit() - spent
+	var carried models.Money
+	switch mode := categ.Rollover.Mode; {
+	case left > 0 && (mode == models.RolloverSurplus || mode == models.RolloverBoth):
+		carried = left
+	case left < 0 && (mode == models.RolloverDebt || mode == models.RolloverBoth):
+		carried = left
+	}
+	if capAmount := categ.Rollover.Cap; capAmount > 0 && carried.Abs() > capAmount {
+		if carried < 0 {
+			return -capAmount
+		}
+		return capAmount
+	}
+	return carried
+}
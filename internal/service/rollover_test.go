@@ -0,0 +1,91 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+func TestCarryOver(t *testing.T) {
+	category := func(mode string, cap, carried models.Money) models.Category {
+		categ := models.Category{Name: "food", Limit: 1000, Carried: carried}
+		if mode != "" {
+			categ.Rollover = &models.Rollover{Mode: mode, Cap: cap}
+		}
+		return categ
+	}
+	tests := []struct {
+		name  string
+		categ models.Category
+		spent models.Money
+		want  models.Money
+	}{
+		{"no rollover", category("", 0, 0), 400, 0},
+		{"none", category(models.RolloverNone, 0, 0), 400, 0},
+		{"surplus", category(models.RolloverSurplus, 0, 0), 400, 600},
+		{"surplus ignores debt", category(models.RolloverSurplus, 0, 0), 1400, 0},
+		{"debt", category(models.RolloverDebt, 0, 0), 1400, -400},
+		{"debt ignores surplus", category(models.RolloverDebt, 0, 0), 400, 0},
+		{"both with surplus", category(models.RolloverBoth, 0, 0), 400, 600},
+		{"both with debt", category(models.RolloverBoth, 0, 0), 1400, -400},
+		{"exactly spent", category(models.RolloverBoth, 0, 0), 1000, 0},
+		{"surplus over the cap", category(models.RolloverBoth, 250, 0), 400, 250},
+		{"debt over the cap", category(models.RolloverBoth, 250, 0), 1400, -250},
+		{"within the cap", category(models.RolloverBoth, 250, 0), 900, 100},
+		{"carried surplus adds to the limit", category(models.RolloverSurplus, 0, 300), 400, 900},
+		{"carried debt takes from the limit", category(models.RolloverDebt, 0, -300), 900, -200},
+		{"carried amount counts towards the cap", category(models.RolloverSurplus, 500, 300), 100, 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := carryOver(tt.categ, tt.spent); got != tt.want {
+				t.Fatalf("carryOver(%s of %s, spent %s) = %s, want %s",
+					tt.categ.Name, tt.categ.EffectiveLimit(), tt.spent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRollover(t *testing.T) {
+	tests := []struct {
+		name     string
+		rollover *models.Rollover
+		err      error
+	}{
+		{"no rollover", nil, nil},
+		{"valid", &models.Rollover{Mode: models.RolloverBoth, Cap: 100}, nil},
+		{"unknown mode", &models.Rollover{Mode: "all"}, apperrors.ErrInvalidArgument},
+		{"negative cap", &models.Rollover{Mode: models.RolloverSurplus, Cap: -100}, apperrors.ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before models.Rollover
+			if tt.rollover != nil {
+				before = *tt.rollover
+			}
+			if err := validateRollover(tt.rollover); !errors.Is(err, tt.err) {
+				t.Fatalf("validateRollover() error = %v, want %v", err, tt.err)
+			}
+			if tt.rollover != nil && *tt.rollover != before {
+				t.Fatalf("validateRollover() changed the rollover to %+v", *tt.rollover)
+			}
+		})
+	}
+}
+
+func TestAbsRollover(t *testing.T) {
+	negative := &models.Rollover{Mode: models.RolloverDebt, Cap: -100}
+	abs := absRollover(negative)
+	if abs.Cap != 100 || abs.Mode != models.RolloverDebt {
+		t.Fatalf("absRollover() = %+v", *abs)
+	}
+	if negative.Cap != -100 {
+		t.Fatal("absRollover() changed its argument")
+	}
+	positive := &models.Rollover{Mode: models.RolloverDebt, Cap: 100}
+	if absRollover(positive) != positive || absRollover(nil) != nil {
+		t.Fatal("absRollover() copied a rollover without a negative cap")
+	}
+}
@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/repository/memory"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/service"
+)
+
+type testUser struct{}
+
+func (testUser) GetUser(ctx context.Context, id string) (string, string, error) {
+	return id, "name", nil
+}
+
+type testRepos struct {
+	budgets  *memory.BudgetRepo
+	expenses *memory.ExpenseRepo
+	outbox   *memory.OutboxRepo
+}
+
+func newTestService() (*service.BudgetService, testRepos) {
+	repos := testRepos{
+		budgets:  memory.NewBudgetRepository(),
+		expenses: memory.NewExpenseRepository(),
+		outbox:   memory.NewOutboxRepository(),
+	}
+	s := service.NewBudgetService(repos.budgets, repos.expenses, memory.NewTemplateRepository(), memory.NewAuditRepository(),
+		repos.outbox, memory.NewTransactor(), nil, memory.NewLocker(), testUser{}, nil, "USD")
+	return s, repos
+}
+
+// budgetNames returns the names of the budgets of the user in the repository.
+func budgetNames(t *testing.T, repos testRepos, userID string) []string {
+	t.Helper()
+	budgets, err := repos.budgets.GetBudgetList(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, budget := range budgets {
+		names = append(names, budget.Name)
+	}
+	return names
+}
+
+// pendingEvents returns the number of events waiting in the outbox.
+func pendingEvents(t *testing.T, repos testRepos) int {
+	t.Helper()
+	events, err := repos.outbox.ClaimEvents(context.Background(), time.Now(), time.Minute, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(events)
+}
+
+func mustAddBudget(t *testing.T, s *service.BudgetService, budget models.CreateBudget) string {
+	t.Helper()
+	id, err := s.AddBudget(context.Background(), budget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
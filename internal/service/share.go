@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// budgetForRole returns a budget the user has at least the required role on.
+// Budgets the user has no access to at all are not found.
+func (s *BudgetService) budgetForRole(ctx context.Context, userID, budgetID, required string) (*models.Budget, error) {
+	budget, err := s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if budget == nil {
+		return nil, apperrors.NotFound("budget is not found")
+	}
+	if err := requireRole(budget, userID, required); err != nil {
+		return nil, err
+	}
+	return budget, nil
+}
+
+func requireRole(budget *models.Budget, userID, required string) error {
+	if !models.RoleAllows(budget.RoleOf(userID), required) {
+		return apperrors.PermissionDenied("%s role on the budget is required", required)
+	}
+	return nil
+}
+
+// ShareBudget gives a user the editor or viewer role on a budget of the
+// caller, or changes the role of an existing member.
+func (s *BudgetService) ShareBudget(ctx context.Context, share models.ShareBudget) (*models.Budget, error) {
+	user, _, err := s.User.GetUser(ctx, share.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	if share.Role != models.RoleEditor && share.Role != models.RoleViewer {
+		return nil, apperrors.InvalidArgument("invalid role: %s, expected %s or %s", share.Role, models.RoleEditor, models.RoleViewer)
+	}
+	budget, err := s.budgetForRole(ctx, share.UserID, share.BudgetID, models.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(budget, share.Version); err != nil {
+		return nil, err
+	}
+	if share.MemberID == budget.UserID {
+		return nil, apperrors.InvalidArgument("the owner cannot be a member of the budget")
+	}
+	invitee, _, err := s.User.GetUser(ctx, share.MemberID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if invitee == "" {
+		return nil, apperrors.NotFound("invited user not found")
+	}
+
+	members := slices.Clone(budget.Members)
+	i := slices.IndexFunc(members, func(m models.Member) bool { return m.UserID == share.MemberID })
+	if i < 0 {
+		members = append(members, models.Member{UserID: share.MemberID, Role: share.Role, AddedAt: time.Now().UTC().Truncate(time.Millisecond)})
+	} else if members[i].Role == share.Role {
+		return budget, nil
+	} else {
+		members[i].Role = share.Role
+	}
+	return s.updateMembers(ctx, share.UserID, "ShareBudget", models.EventBudgetShared, budget, members)
+}
+
+// RevokeShare removes a member from a budget. The owner may remove anyone,
+// members only themselves.
+func (s *BudgetService) RevokeShare(ctx context.Context, userID, budgetID, memberID string, version *int64) error {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if user == "" {
+		return apperrors.NotFound("user not found")
+	}
+	budget, err := s.budgetForRole(ctx, userID, budgetID, models.RoleViewer)
+	if err != nil {
+		return err
+	}
+	if userID != memberID {
+		if err := requireRole(budget, userID, models.RoleOwner); err != nil {
+			return err
+		}
+	}
+	if err := checkVersion(budget, version); err != nil {
+		return err
+	}
+	members := slices.DeleteFunc(slices.Clone(budget.Members), func(m models.Member) bool { return m.UserID == memberID })
+	if len(members) == len(budget.Members) {
+		return apperrors.NotFound("member is not found")
+	}
+	_, err = s.updateMembers(ctx, userID, "RevokeShare", models.EventBudgetUnshared, budget, members)
+	return err
+}
+
+func (s *BudgetService) updateMembers(ctx context.Context, actor, operation, eventType string, budget *models.Budget, members []models.Member) (*models.Budget, error) {
+	updated := *budget
+	updated.Members = members
+	updated.Version++
+	err := s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.UpdateMembers(ctx, budget.UserID, budget.ID, members, budget.Version); err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, actor, operation, budget, &updated); err != nil {
+			return nil, err
+		}
+		return budgetEvents(eventType, budget, &updated), nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// memberIDs lists the members of the budget before and after a change, so
+// removed members learn about their removal too.
+func memberIDs(before, after *models.Budget) []string {
+	var ids []string
+	for _, budget := range []*models.Budget{before, after} {
+		if budget == nil {
+			continue
+		}
+		for _, member := range budget.Members {
+			if !slices.Contains(ids, member.UserID) {
+				ids = append(ids, member.UserID)
+			}
+		}
+	}
+	return ids
+}
+
+// ListMembers returns the users with access to a budget, the owner first.
+func (s *BudgetService) ListMembers(ctx context.Context, userID, budgetID string) ([]models.Member, error) {
+	budget, err := s.GetBudget(ctx, userID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]models.Member, 0, len(budget.Members)+1)
+	members = append(members, models.Member{UserID: budget.UserID, Role: models.RoleOwner})
+	return append(members, budget.Members...), nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+type TemplateRepository interface {
+	// AddTemplate and UpdateTemplate return an AlreadyExists error when the
+	// user has another template with the same name.
+	AddTemplate(ctx context.Context, template models.Template) (string, error)
+	GetTemplate(ctx context.Context, userID, templateID string) (*models.Template, error)
+	// ListTemplates orders the templates by name.
+	ListTemplates(ctx context.Context, userID string) ([]models.Template, error)
+	UpdateTemplate(ctx context.Context, template models.Template) error
+	DeleteTemplate(ctx context.Context, userID, templateID string) error
+}
+
+func (s *BudgetService) CreateTemplate(ctx context.Context, create models.CreateTemplate) (*models.Template, error) {
+	user, _, err := s.User.GetUser(ctx, create.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	template, err := s.newTemplate(create)
+	if err != nil {
+		return nil, err
+	}
+	template.CreatedAt = template.UpdatedAt
+	template.ID, err = s.Templates.AddTemplate(ctx, template)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &template, nil
+}
+
+// newTemplate validates the request and returns the template it describes.
+func (s *BudgetService) newTemplate(create models.CreateTemplate) (models.Template, error) {
+	if create.Limit < 0 {
+		create.Limit *= -1
+	}
+	if create.Allocation == "" {
+		create.Allocation = models.AllocationUnrestricted
+	}
+	if err := validateAllocation(create.Allocation); err != nil {
+		return models.Template{}, err
+	}
+	currency, err := normalizeCurrency(create.Currency, s.DefaultCurrency)
+	if err != nil {
+		return models.Template{}, err
+	}
+	categories := make([]models.TemplateCategory, 0, len(create.Categories))
+	names := make(map[string]bool, len(create.Categories))
+	for _, categ := range create.Categories {
+		if categ.Name == "" {
+			return models.Template{}, apperrors.InvalidArgument("category name is required")
+		}
+		if names[categ.Name] {
+			return models.Template{}, apperrors.InvalidArgument("category %s is listed twice", categ.Name)
+		}
+		names[categ.Name] = true
+		if categ.Limit < 0 {
+			categ.Limit *= -1
+		}
+		categ.Rollover = absRollover(categ.Rollover)
+		if categ.Limit != 0 && categ.Percent != 0 {
+			return models.Template{}, apperrors.InvalidArgument("category %s has both a limit and a percentage", categ.Name)
+		}
+		if categ.Percent < 0 || categ.Percent > models.FullPercent {
+			return models.Template{}, apperrors.InvalidArgument("percentage of category %s must be between 0 and 100", categ.Name)
+		}
+		if err := validateRollover(categ.Rollover); err != nil {
+			return models.Template{}, err
+		}
+		categories = append(categories, categ)
+	}
+	return models.Template{
+		UserID:     create.UserID,
+		Name:       create.Name,
+		Limit:      create.Limit,
+		Currency:   currency,
+		Allocation: create.Allocation,
+		Categories: categories,
+		// MongoDB keeps milliseconds
+		UpdatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}, nil
+}
+
+func (s *BudgetService) GetTemplate(ctx context.Context, userID, templateID string) (*models.Template, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	template, err := s.Templates.GetTemplate(ctx, userID, templateID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if template == nil {
+		return nil, apperrors.NotFound("template is not found")
+	}
+	return template, nil
+}
+
+func (s *BudgetService) ListTemplates(ctx context.Context, userID string) ([]models.Template, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	templates, err := s.Templates.ListTemplates(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (s *BudgetService) UpdateTemplate(ctx context.Context, update models.UpdateTemplate) (*models.Template, error) {
+	user, _, err := s.User.GetUser(ctx, update.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	template, err := s.newTemplate(update.CreateTemplate)
+	if err != nil {
+		return nil, err
+	}
+	template.ID = update.TemplateID
+	if err := s.Templates.UpdateTemplate(ctx, template); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return s.GetTemplate(ctx, update.UserID, update.TemplateID)
+}
+
+func (s *BudgetService) DeleteTemplate(ctx context.Context, userID, templateID string) error {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if user == "" {
+		return apperrors.NotFound("user not found")
+	}
+	if err := s.Templates.DeleteTemplate(ctx, userID, templateID); err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
+
+// SaveBudgetAsTemplate snapshots the limits and categories of a budget the
+// user has access to into a new template of the user. Carried amounts belong
+// to the period and are left out.
+func (s *BudgetService) SaveBudgetAsTemplate(ctx context.Context, save models.SaveBudgetAsTemplate) (*models.Template, error) {
+	budget, err := s.GetBudget(ctx, save.UserID, save.BudgetID)
+	if err != nil {
+		return nil, err
+	}
+	create := models.CreateTemplate{
+		UserID:     save.UserID,
+		Name:       save.Name,
+		Limit:      budget.Limit,
+		Currency:   s.budgetCurrency(*budget),
+		Allocation: budget.Allocation,
+		Categories: make([]models.TemplateCategory, 0, len(budget.Category)),
+	}
+	if create.Name == "" {
+		create.Name = budget.Name
+	}
+	for _, categ := range budget.Category {
+		create.Categories = append(create.Categories, models.TemplateCategory{
+			Name:     categ.Name,
+			Limit:    categ.Limit,
+			Rollover: categ.Rollover,
+		})
+	}
+	return s.CreateTemplate(ctx, create)
+}
+
+// AddBudgetFromTemplate creates a budget with all categories of the template
+// in a single insert, so it either exists complete or not at all.
+func (s *BudgetService) AddBudgetFromTemplate(ctx context.Context, add models.AddBudgetFromTemplate) (*models.Budget, []string, error) {
+	template, err := s.GetTemplate(ctx, add.UserID, add.TemplateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if add.Period == "" && (add.StartDate == "" || add.EndDate == "") {
+		return nil, nil, apperrors.InvalidArgument("either a period or start and end dates are required")
+	}
+	create := models.CreateBudget{
+		UserID:     add.UserID,
+		Name:       add.Name,
+		Limit:      template.Limit,
+		Currency:   template.Currency,
+		Allocation: template.Allocation,
+		Period:     add.Period,
+		StartDate:  add.StartDate,
+		EndDate:    add.EndDate,
+		Recurring:  add.Recurring,
+	}
+	if create.Name == "" {
+		create.Name = template.Name
+	}
+	budget, err := s.newBudget(create)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, categ := range template.Categories {
+		limit, err := categ.LimitOf(budget.Limit)
+		if err != nil {
+			return nil, nil, apperrors.InvalidArgument("category %s limit: %v", categ.Name, err)
+		}
+		budget.Category = append(budget.Category, models.Category{
+			Name:     categ.Name,
+			Limit:    limit,
+			Rollover: categ.Rollover,
+		})
+	}
+	warnings, err := checkBudgetAllocation(budget.Allocation, budget.Limit, budget.Category)
+	if err != nil {
+		return nil, nil, err
+	}
+	created, err := s.createBudget(ctx, "AddBudgetFromTemplate", budget)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, warnings, nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// ListDeleted returns the user's budgets and categories that are in the trash.
+// Categories of deleted budgets are restored with their budget, so they are
+// not listed on their own.
+func (s *BudgetService) ListDeleted(ctx context.Context, userID string) ([]models.Budget, []models.DeletedCategory, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+	if user == "" {
+		return nil, nil, apperrors.NotFound("user not found")
+	}
+	budgets, err := s.BudgetRepo.GetDeletedBudgets(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+	categories, err := s.BudgetRepo.GetDeletedCategories(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+	return budgets, categories, nil
+}
+
+func (s *BudgetService) RestoreBudget(ctx context.Context, userID, budgetID string, version *int64) (*models.Budget, error) {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if user == "" {
+		return nil, apperrors.NotFound("user not found")
+	}
+	deleted, err := s.BudgetRepo.GetDeletedBudgets(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	var budget *models.Budget
+	for i := range deleted {
+		if deleted[i].ID == budgetID {
+			budget = &deleted[i]
+			break
+		}
+	}
+	if budget == nil {
+		return nil, apperrors.NotFound("deleted budget is not found")
+	}
+	if err := checkVersion(budget, version); err != nil {
+		return nil, err
+	}
+	// another budget may have taken the period while this one was deleted
+	unlock, err := s.Locks.Lock(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer unlock()
+	if err := s.checkOverlap(ctx, *budget); err != nil {
+		return nil, err
+	}
+	var restored *models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.RestoreBudget(ctx, userID, budgetID, budget.Version); err != nil {
+			return nil, err
+		}
+		var err error
+		restored, err = s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, userID, "RestoreBudget", budget, restored); err != nil {
+			return nil, err
+		}
+		return budgetEvents(models.EventBudgetRestored, budget, restored), nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return restored, nil
+}
+
+func (s *BudgetService) RestoreCategory(ctx context.Context, userID, budgetID, catID string, version *int64) (*models.Budget, []string, error) {
+	budget, err := s.GetBudget(ctx, userID, budgetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := requireRole(budget, userID, models.RoleEditor); err != nil {
+		return nil, nil, err
+	}
+	if err := checkVersion(budget, version); err != nil {
+		return nil, nil, err
+	}
+	deleted, err := s.BudgetRepo.GetDeletedCategories(ctx, budget.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+	var categ *models.Category
+	for i := range deleted {
+		if deleted[i].BudgetID == budgetID && deleted[i].Category.ID == catID {
+			categ = &deleted[i].Category
+			break
+		}
+	}
+	if categ == nil {
+		return nil, nil, apperrors.NotFound("deleted category is not found")
+	}
+	// the restore is applied at the version read here, which keeps both
+	// checks atomic
+	if s.checkForDuplicateCategory(categ.Name, budget.Category) {
+		return nil, nil, apperrors.AlreadyExists("category with name %s is already added to this budget", categ.Name)
+	}
+	warnings, err := checkCategoryAllocation(budget, "", categ.Limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := *budget
+	before.Category = append(append([]models.Category{}, budget.Category...), *categ)
+	var restored *models.Budget
+	err = s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		if err := s.BudgetRepo.RestoreCategory(ctx, budget.UserID, budgetID, catID, budget.Version); err != nil {
+			return nil, err
+		}
+		var err error
+		restored, err = s.BudgetRepo.GetBudget(ctx, userID, budgetID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.audit(ctx, userID, "RestoreCategory", &before, restored); err != nil {
+			return nil, err
+		}
+		return categoryEvents(models.EventCategoryRestored, catID, &before, restored), nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+	return restored, warnings, nil
+}
+
+// RunPurge removes budgets and categories that have been in the trash for
+// longer than retention, every interval until ctx is done.
+func (s *BudgetService) RunPurge(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.PurgeDeleted(ctx, time.Now().UTC().Add(-retention)); err != nil && ctx.Err() == nil {
+			log.Printf("trash purge failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDeleted removes everything deleted before the given time, together
+// with the expenses of the purged budgets. Both happen in one transaction, the
+// expenses could not be found anymore once the budgets are gone.
+func (s *BudgetService) PurgeDeleted(ctx context.Context, before time.Time) error {
+	return s.withEvents(ctx, func(ctx context.Context) ([]models.Event, error) {
+		purged, err := s.BudgetRepo.PurgeDeleted(ctx, before)
+		if err != nil {
+			return nil, err
+		}
+		events := []models.Event{}
+		ids := make([]string, len(purged))
+		for i := range purged {
+			ids[i] = purged[i].ID
+			events = append(events, budgetEvents(models.EventBudgetPurged, &purged[i], nil)...)
+			if err := s.audit(ctx, systemActor, "PurgeDeleted", &purged[i], nil); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.ExpenseRepo.DeleteBudgetExpenses(ctx, ids); err != nil {
+			return nil, err
+		}
+		return events, nil
+	})
+}
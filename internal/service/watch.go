@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/apperrors"
+	"github.com/justIGreK/MoneyKeeper-Budget/internal/models"
+)
+
+// EventFeed fans the events published by the outbox relay out to watchers.
+type EventFeed interface {
+	// Subscribe returns the events matching match published from now on and,
+	// with after set, the ones published since then that are still retained.
+	Subscribe(after *models.FeedPosition, buffer int, match func(models.Event) bool) (*models.Subscription, error)
+}
+
+// watchBuffer is how many events a watcher may lag behind before it is
+// disconnected and has to resume.
+const watchBuffer = 64
+
+// WatchBudgets passes the changes of the user's own and shared budgets to
+// send, together with the token to resume after them, until ctx is done or
+// send fails. It starts with a WatchStarted event, so a watcher has a token
+// before the first change.
+func (s *BudgetService) WatchBudgets(ctx context.Context, userID, resumeToken string, send func(event models.Event, resumeToken string) error) error {
+	user, _, err := s.User.GetUser(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if user == "" {
+		return apperrors.NotFound("user not found")
+	}
+	var after *models.FeedPosition
+	if resumeToken != "" {
+		position, err := decodeResumeToken(resumeToken)
+		if err != nil {
+			return err
+		}
+		after = &position
+	}
+	sub, err := s.Feed.Subscribe(after, watchBuffer, func(event models.Event) bool {
+		return event.UserID == userID || slices.Contains(event.Members, userID)
+	})
+	if err != nil {
+		return err
+	}
+	defer sub.Cancel()
+
+	started := models.Event{Type: models.EventWatchStarted, UserID: userID, OccurredAt: time.Now().UTC()}
+	if err := sendWatchEvent(send, models.WatchEvent{Event: started, Position: sub.Position}); err != nil {
+		return err
+	}
+	for _, event := range sub.Replay {
+		if err := sendWatchEvent(send, event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-sub.Events:
+			if !ok {
+				return apperrors.Unavailable("watch fell behind, resume with the last token")
+			}
+			if err := sendWatchEvent(send, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendWatchEvent(send func(models.Event, string) error, event models.WatchEvent) error {
+	token, err := encodeResumeToken(event.Position)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	return send(event.Event, token)
+}
+
+func encodeResumeToken(position models.FeedPosition) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeResumeToken(value string) (models.FeedPosition, error) {
+	var position models.FeedPosition
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return position, apperrors.InvalidArgument("invalid resume token")
+	}
+	if err := json.Unmarshal(data, &position); err != nil || position.Epoch == "" {
+		return position, apperrors.InvalidArgument("invalid resume token")
+	}
+	return position, nil
+}
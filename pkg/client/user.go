@@ -3,34 +3,63 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	user "github.com/justIGreK/MoneyKeeper-User/pkg/go/user"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 )
 
 type UserClient struct {
-	client user.UserServiceClient
+	conn    *grpc.ClientConn
+	client  user.UserServiceClient
+	timeout time.Duration
 }
 
-func NewUserClient(serviceAddress string) (*UserClient, error) {
-	conn, err := grpc.NewClient(serviceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewUserClient(serviceAddress string, creds credentials.TransportCredentials, timeout time.Duration) (*UserClient, error) {
+	conn, err := grpc.NewClient(serviceAddress, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		return nil, err
 	}
 	return &UserClient{
-		client: user.NewUserServiceClient(conn),
+		conn:    conn,
+		client:  user.NewUserServiceClient(conn),
+		timeout: timeout,
 	}, nil
 }
 
 func (uc *UserClient) GetUser(ctx context.Context, id string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.timeout)
+	defer cancel()
 	req := &user.GetUserRequest{UserId: id}
 	res, err := uc.client.GetUser(ctx, req)
 	if err != nil {
 		return "", "", err
 	}
-	if req == nil {
+	if res == nil {
 		return "", "", errors.New("user is not found")
 	}
 	return res.Id, res.Name, nil
 }
+
+// Ping waits until the connection to the user service is ready or ctx is done.
+func (uc *UserClient) Ping(ctx context.Context) error {
+	for {
+		state := uc.conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Idle:
+			uc.conn.Connect()
+		}
+		if !uc.conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("user service connection is %s", state)
+		}
+	}
+}
+
+func (uc *UserClient) Close() error {
+	return uc.conn.Close()
+}
@@ -20,14 +20,40 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BudgetService_AddBudget_FullMethodName      = "/budget.BudgetService/AddBudget"
-	BudgetService_AddCategory_FullMethodName    = "/budget.BudgetService/AddCategory"
-	BudgetService_UpdateCategory_FullMethodName = "/budget.BudgetService/UpdateCategory"
-	BudgetService_DeleteCategory_FullMethodName = "/budget.BudgetService/DeleteCategory"
-	BudgetService_GetBudget_FullMethodName      = "/budget.BudgetService/GetBudget"
-	BudgetService_GetBudgetList_FullMethodName  = "/budget.BudgetService/GetBudgetList"
-	BudgetService_UpdateBudget_FullMethodName   = "/budget.BudgetService/UpdateBudget"
-	BudgetService_DeleteBudget_FullMethodName   = "/budget.BudgetService/DeleteBudget"
+	BudgetService_AddBudget_FullMethodName             = "/budget.BudgetService/AddBudget"
+	BudgetService_AddCategory_FullMethodName           = "/budget.BudgetService/AddCategory"
+	BudgetService_UpdateCategory_FullMethodName        = "/budget.BudgetService/UpdateCategory"
+	BudgetService_DeleteCategory_FullMethodName        = "/budget.BudgetService/DeleteCategory"
+	BudgetService_GetBudget_FullMethodName             = "/budget.BudgetService/GetBudget"
+	BudgetService_GetBudgetList_FullMethodName         = "/budget.BudgetService/GetBudgetList"
+	BudgetService_GetBudgetSummary_FullMethodName      = "/budget.BudgetService/GetBudgetSummary"
+	BudgetService_UpdateBudget_FullMethodName          = "/budget.BudgetService/UpdateBudget"
+	BudgetService_DeleteBudget_FullMethodName          = "/budget.BudgetService/DeleteBudget"
+	BudgetService_AddExpense_FullMethodName            = "/budget.BudgetService/AddExpense"
+	BudgetService_ListExpenses_FullMethodName          = "/budget.BudgetService/ListExpenses"
+	BudgetService_DeleteExpense_FullMethodName         = "/budget.BudgetService/DeleteExpense"
+	BudgetService_ListBudgetSeries_FullMethodName      = "/budget.BudgetService/ListBudgetSeries"
+	BudgetService_StopRecurrence_FullMethodName        = "/budget.BudgetService/StopRecurrence"
+	BudgetService_ListDeleted_FullMethodName           = "/budget.BudgetService/ListDeleted"
+	BudgetService_RestoreBudget_FullMethodName         = "/budget.BudgetService/RestoreBudget"
+	BudgetService_RestoreCategory_FullMethodName       = "/budget.BudgetService/RestoreCategory"
+	BudgetService_GetBudgetHistory_FullMethodName      = "/budget.BudgetService/GetBudgetHistory"
+	BudgetService_WatchBudgets_FullMethodName          = "/budget.BudgetService/WatchBudgets"
+	BudgetService_ShareBudget_FullMethodName           = "/budget.BudgetService/ShareBudget"
+	BudgetService_RevokeShare_FullMethodName           = "/budget.BudgetService/RevokeShare"
+	BudgetService_ListMembers_FullMethodName           = "/budget.BudgetService/ListMembers"
+	BudgetService_BackupUserData_FullMethodName        = "/budget.BudgetService/BackupUserData"
+	BudgetService_RestoreUserData_FullMethodName       = "/budget.BudgetService/RestoreUserData"
+	BudgetService_ExportBudgets_FullMethodName         = "/budget.BudgetService/ExportBudgets"
+	BudgetService_ImportBudgets_FullMethodName         = "/budget.BudgetService/ImportBudgets"
+	BudgetService_CloneBudget_FullMethodName           = "/budget.BudgetService/CloneBudget"
+	BudgetService_CreateTemplate_FullMethodName        = "/budget.BudgetService/CreateTemplate"
+	BudgetService_GetTemplate_FullMethodName           = "/budget.BudgetService/GetTemplate"
+	BudgetService_ListTemplates_FullMethodName         = "/budget.BudgetService/ListTemplates"
+	BudgetService_UpdateTemplate_FullMethodName        = "/budget.BudgetService/UpdateTemplate"
+	BudgetService_DeleteTemplate_FullMethodName        = "/budget.BudgetService/DeleteTemplate"
+	BudgetService_SaveBudgetAsTemplate_FullMethodName  = "/budget.BudgetService/SaveBudgetAsTemplate"
+	BudgetService_AddBudgetFromTemplate_FullMethodName = "/budget.BudgetService/AddBudgetFromTemplate"
 )
 
 // BudgetServiceClient is the client API for BudgetService service.
@@ -40,8 +66,34 @@ type BudgetServiceClient interface {
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetBudget(ctx context.Context, in *GetBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
 	GetBudgetList(ctx context.Context, in *GetBudgetListRequest, opts ...grpc.CallOption) (*GetBudgetListResponse, error)
+	GetBudgetSummary(ctx context.Context, in *GetBudgetRequest, opts ...grpc.CallOption) (*GetBudgetSummaryResponse, error)
 	UpdateBudget(ctx context.Context, in *UpdateBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
 	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddExpense(ctx context.Context, in *AddExpenseRequest, opts ...grpc.CallOption) (*AddExpenseResponse, error)
+	ListExpenses(ctx context.Context, in *ListExpensesRequest, opts ...grpc.CallOption) (*ListExpensesResponse, error)
+	DeleteExpense(ctx context.Context, in *DeleteExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListBudgetSeries(ctx context.Context, in *ListBudgetSeriesRequest, opts ...grpc.CallOption) (*GetBudgetListResponse, error)
+	StopRecurrence(ctx context.Context, in *StopRecurrenceRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error)
+	RestoreBudget(ctx context.Context, in *RestoreBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
+	RestoreCategory(ctx context.Context, in *RestoreCategoryRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
+	GetBudgetHistory(ctx context.Context, in *GetBudgetHistoryRequest, opts ...grpc.CallOption) (*GetBudgetHistoryResponse, error)
+	WatchBudgets(ctx context.Context, in *WatchBudgetsRequest, opts ...grpc.CallOption) (BudgetService_WatchBudgetsClient, error)
+	ShareBudget(ctx context.Context, in *ShareBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	BackupUserData(ctx context.Context, in *BackupUserDataRequest, opts ...grpc.CallOption) (*BackupUserDataResponse, error)
+	RestoreUserData(ctx context.Context, in *RestoreUserDataRequest, opts ...grpc.CallOption) (*RestoreUserDataResponse, error)
+	ExportBudgets(ctx context.Context, in *ExportBudgetsRequest, opts ...grpc.CallOption) (BudgetService_ExportBudgetsClient, error)
+	ImportBudgets(ctx context.Context, opts ...grpc.CallOption) (BudgetService_ImportBudgetsClient, error)
+	CloneBudget(ctx context.Context, in *CloneBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SaveBudgetAsTemplate(ctx context.Context, in *SaveBudgetAsTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	AddBudgetFromTemplate(ctx context.Context, in *AddBudgetFromTemplateRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error)
 }
 
 type budgetServiceClient struct {
@@ -106,6 +158,15 @@ func (c *budgetServiceClient) GetBudgetList(ctx context.Context, in *GetBudgetLi
 	return out, nil
 }
 
+func (c *budgetServiceClient) GetBudgetSummary(ctx context.Context, in *GetBudgetRequest, opts ...grpc.CallOption) (*GetBudgetSummaryResponse, error) {
+	out := new(GetBudgetSummaryResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *budgetServiceClient) UpdateBudget(ctx context.Context, in *UpdateBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
 	out := new(GetBudgetResponse)
 	err := c.cc.Invoke(ctx, BudgetService_UpdateBudget_FullMethodName, in, out, opts...)
@@ -124,6 +185,302 @@ func (c *budgetServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudget
 	return out, nil
 }
 
+func (c *budgetServiceClient) AddExpense(ctx context.Context, in *AddExpenseRequest, opts ...grpc.CallOption) (*AddExpenseResponse, error) {
+	out := new(AddExpenseResponse)
+	err := c.cc.Invoke(ctx, BudgetService_AddExpense_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListExpenses(ctx context.Context, in *ListExpensesRequest, opts ...grpc.CallOption) (*ListExpensesResponse, error) {
+	out := new(ListExpensesResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListExpenses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteExpense(ctx context.Context, in *DeleteExpenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BudgetService_DeleteExpense_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListBudgetSeries(ctx context.Context, in *ListBudgetSeriesRequest, opts ...grpc.CallOption) (*GetBudgetListResponse, error) {
+	out := new(GetBudgetListResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListBudgetSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) StopRecurrence(ctx context.Context, in *StopRecurrenceRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
+	out := new(GetBudgetResponse)
+	err := c.cc.Invoke(ctx, BudgetService_StopRecurrence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error) {
+	out := new(ListDeletedResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListDeleted_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) RestoreBudget(ctx context.Context, in *RestoreBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
+	out := new(GetBudgetResponse)
+	err := c.cc.Invoke(ctx, BudgetService_RestoreBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) RestoreCategory(ctx context.Context, in *RestoreCategoryRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
+	out := new(GetBudgetResponse)
+	err := c.cc.Invoke(ctx, BudgetService_RestoreCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetBudgetHistory(ctx context.Context, in *GetBudgetHistoryRequest, opts ...grpc.CallOption) (*GetBudgetHistoryResponse, error) {
+	out := new(GetBudgetHistoryResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetBudgetHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) WatchBudgets(ctx context.Context, in *WatchBudgetsRequest, opts ...grpc.CallOption) (BudgetService_WatchBudgetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BudgetService_ServiceDesc.Streams[0], BudgetService_WatchBudgets_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &budgetServiceWatchBudgetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BudgetService_WatchBudgetsClient interface {
+	Recv() (*BudgetEvent, error)
+	grpc.ClientStream
+}
+
+type budgetServiceWatchBudgetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *budgetServiceWatchBudgetsClient) Recv() (*BudgetEvent, error) {
+	m := new(BudgetEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *budgetServiceClient) ShareBudget(ctx context.Context, in *ShareBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
+	out := new(GetBudgetResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ShareBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BudgetService_RevokeShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) BackupUserData(ctx context.Context, in *BackupUserDataRequest, opts ...grpc.CallOption) (*BackupUserDataResponse, error) {
+	out := new(BackupUserDataResponse)
+	err := c.cc.Invoke(ctx, BudgetService_BackupUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) RestoreUserData(ctx context.Context, in *RestoreUserDataRequest, opts ...grpc.CallOption) (*RestoreUserDataResponse, error) {
+	out := new(RestoreUserDataResponse)
+	err := c.cc.Invoke(ctx, BudgetService_RestoreUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ExportBudgets(ctx context.Context, in *ExportBudgetsRequest, opts ...grpc.CallOption) (BudgetService_ExportBudgetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BudgetService_ServiceDesc.Streams[1], BudgetService_ExportBudgets_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &budgetServiceExportBudgetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BudgetService_ExportBudgetsClient interface {
+	Recv() (*CsvChunk, error)
+	grpc.ClientStream
+}
+
+type budgetServiceExportBudgetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *budgetServiceExportBudgetsClient) Recv() (*CsvChunk, error) {
+	m := new(CsvChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *budgetServiceClient) ImportBudgets(ctx context.Context, opts ...grpc.CallOption) (BudgetService_ImportBudgetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BudgetService_ServiceDesc.Streams[2], BudgetService_ImportBudgets_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &budgetServiceImportBudgetsClient{stream}
+	return x, nil
+}
+
+type BudgetService_ImportBudgetsClient interface {
+	Send(*ImportBudgetsRequest) error
+	CloseAndRecv() (*ImportBudgetsResponse, error)
+	grpc.ClientStream
+}
+
+type budgetServiceImportBudgetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *budgetServiceImportBudgetsClient) Send(m *ImportBudgetsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *budgetServiceImportBudgetsClient) CloseAndRecv() (*ImportBudgetsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportBudgetsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *budgetServiceClient) CloneBudget(ctx context.Context, in *CloneBudgetRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
+	out := new(GetBudgetResponse)
+	err := c.cc.Invoke(ctx, BudgetService_CloneBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_CreateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, BudgetService_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_UpdateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BudgetService_DeleteTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) SaveBudgetAsTemplate(ctx context.Context, in *SaveBudgetAsTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, BudgetService_SaveBudgetAsTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) AddBudgetFromTemplate(ctx context.Context, in *AddBudgetFromTemplateRequest, opts ...grpc.CallOption) (*GetBudgetResponse, error) {
+	out := new(GetBudgetResponse)
+	err := c.cc.Invoke(ctx, BudgetService_AddBudgetFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BudgetServiceServer is the server API for BudgetService service.
 // All implementations should embed UnimplementedBudgetServiceServer
 // for forward compatibility
@@ -134,8 +491,34 @@ type BudgetServiceServer interface {
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
 	GetBudget(context.Context, *GetBudgetRequest) (*GetBudgetResponse, error)
 	GetBudgetList(context.Context, *GetBudgetListRequest) (*GetBudgetListResponse, error)
+	GetBudgetSummary(context.Context, *GetBudgetRequest) (*GetBudgetSummaryResponse, error)
 	UpdateBudget(context.Context, *UpdateBudgetRequest) (*GetBudgetResponse, error)
 	DeleteBudget(context.Context, *DeleteBudgetRequest) (*emptypb.Empty, error)
+	AddExpense(context.Context, *AddExpenseRequest) (*AddExpenseResponse, error)
+	ListExpenses(context.Context, *ListExpensesRequest) (*ListExpensesResponse, error)
+	DeleteExpense(context.Context, *DeleteExpenseRequest) (*emptypb.Empty, error)
+	ListBudgetSeries(context.Context, *ListBudgetSeriesRequest) (*GetBudgetListResponse, error)
+	StopRecurrence(context.Context, *StopRecurrenceRequest) (*GetBudgetResponse, error)
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error)
+	RestoreBudget(context.Context, *RestoreBudgetRequest) (*GetBudgetResponse, error)
+	RestoreCategory(context.Context, *RestoreCategoryRequest) (*GetBudgetResponse, error)
+	GetBudgetHistory(context.Context, *GetBudgetHistoryRequest) (*GetBudgetHistoryResponse, error)
+	WatchBudgets(*WatchBudgetsRequest, BudgetService_WatchBudgetsServer) error
+	ShareBudget(context.Context, *ShareBudgetRequest) (*GetBudgetResponse, error)
+	RevokeShare(context.Context, *RevokeShareRequest) (*emptypb.Empty, error)
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	BackupUserData(context.Context, *BackupUserDataRequest) (*BackupUserDataResponse, error)
+	RestoreUserData(context.Context, *RestoreUserDataRequest) (*RestoreUserDataResponse, error)
+	ExportBudgets(*ExportBudgetsRequest, BudgetService_ExportBudgetsServer) error
+	ImportBudgets(BudgetService_ImportBudgetsServer) error
+	CloneBudget(context.Context, *CloneBudgetRequest) (*GetBudgetResponse, error)
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*GetTemplateResponse, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*GetTemplateResponse, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*emptypb.Empty, error)
+	SaveBudgetAsTemplate(context.Context, *SaveBudgetAsTemplateRequest) (*GetTemplateResponse, error)
+	AddBudgetFromTemplate(context.Context, *AddBudgetFromTemplateRequest) (*GetBudgetResponse, error)
 }
 
 // UnimplementedBudgetServiceServer should be embedded to have forward compatible implementations.
@@ -160,12 +543,90 @@ func (UnimplementedBudgetServiceServer) GetBudget(context.Context, *GetBudgetReq
 func (UnimplementedBudgetServiceServer) GetBudgetList(context.Context, *GetBudgetListRequest) (*GetBudgetListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetList not implemented")
 }
+func (UnimplementedBudgetServiceServer) GetBudgetSummary(context.Context, *GetBudgetRequest) (*GetBudgetSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetSummary not implemented")
+}
 func (UnimplementedBudgetServiceServer) UpdateBudget(context.Context, *UpdateBudgetRequest) (*GetBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBudget not implemented")
 }
 func (UnimplementedBudgetServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
 }
+func (UnimplementedBudgetServiceServer) AddExpense(context.Context, *AddExpenseRequest) (*AddExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpense not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListExpenses(context.Context, *ListExpensesRequest) (*ListExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpenses not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteExpense(context.Context, *DeleteExpenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpense not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListBudgetSeries(context.Context, *ListBudgetSeriesRequest) (*GetBudgetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBudgetSeries not implemented")
+}
+func (UnimplementedBudgetServiceServer) StopRecurrence(context.Context, *StopRecurrenceRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecurrence not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (UnimplementedBudgetServiceServer) RestoreBudget(context.Context, *RestoreBudgetRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBudget not implemented")
+}
+func (UnimplementedBudgetServiceServer) RestoreCategory(context.Context, *RestoreCategoryRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCategory not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetBudgetHistory(context.Context, *GetBudgetHistoryRequest) (*GetBudgetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetHistory not implemented")
+}
+func (UnimplementedBudgetServiceServer) WatchBudgets(*WatchBudgetsRequest, BudgetService_WatchBudgetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) ShareBudget(context.Context, *ShareBudgetRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareBudget not implemented")
+}
+func (UnimplementedBudgetServiceServer) RevokeShare(context.Context, *RevokeShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedBudgetServiceServer) BackupUserData(context.Context, *BackupUserDataRequest) (*BackupUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupUserData not implemented")
+}
+func (UnimplementedBudgetServiceServer) RestoreUserData(context.Context, *RestoreUserDataRequest) (*RestoreUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUserData not implemented")
+}
+func (UnimplementedBudgetServiceServer) ExportBudgets(*ExportBudgetsRequest, BudgetService_ExportBudgetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) ImportBudgets(BudgetService_ImportBudgetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) CloneBudget(context.Context, *CloneBudgetRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneBudget not implemented")
+}
+func (UnimplementedBudgetServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedBudgetServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) SaveBudgetAsTemplate(context.Context, *SaveBudgetAsTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBudgetAsTemplate not implemented")
+}
+func (UnimplementedBudgetServiceServer) AddBudgetFromTemplate(context.Context, *AddBudgetFromTemplateRequest) (*GetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBudgetFromTemplate not implemented")
+}
 
 // UnsafeBudgetServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BudgetServiceServer will
@@ -286,6 +747,24 @@ func _BudgetService_GetBudgetList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BudgetService_GetBudgetSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetSummary(ctx, req.(*GetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BudgetService_UpdateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateBudgetRequest)
 	if err := dec(in); err != nil {
@@ -322,23 +801,487 @@ func _BudgetService_DeleteBudget_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BudgetService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "budget.BudgetService",
-	HandlerType: (*BudgetServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddBudget",
-			Handler:    _BudgetService_AddBudget_Handler,
-		},
-		{
-			MethodName: "AddCategory",
-			Handler:    _BudgetService_AddCategory_Handler,
-		},
-		{
-			MethodName: "UpdateCategory",
+func _BudgetService_AddExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).AddExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_AddExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).AddExpense(ctx, req.(*AddExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListExpenses(ctx, req.(*ListExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_DeleteExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteExpense(ctx, req.(*DeleteExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListBudgetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListBudgetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListBudgetSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListBudgetSeries(ctx, req.(*ListBudgetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_StopRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).StopRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_StopRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).StopRecurrence(ctx, req.(*StopRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListDeleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_RestoreBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).RestoreBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_RestoreBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).RestoreBudget(ctx, req.(*RestoreBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_RestoreCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).RestoreCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_RestoreCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).RestoreCategory(ctx, req.(*RestoreCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetBudgetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetBudgetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetHistory(ctx, req.(*GetBudgetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_WatchBudgets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBudgetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BudgetServiceServer).WatchBudgets(m, &budgetServiceWatchBudgetsServer{stream})
+}
+
+type BudgetService_WatchBudgetsServer interface {
+	Send(*BudgetEvent) error
+	grpc.ServerStream
+}
+
+type budgetServiceWatchBudgetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *budgetServiceWatchBudgetsServer) Send(m *BudgetEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BudgetService_ShareBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ShareBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ShareBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ShareBudget(ctx, req.(*ShareBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_RevokeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_BackupUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).BackupUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_BackupUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).BackupUserData(ctx, req.(*BackupUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_RestoreUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).RestoreUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_RestoreUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).RestoreUserData(ctx, req.(*RestoreUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ExportBudgets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBudgetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BudgetServiceServer).ExportBudgets(m, &budgetServiceExportBudgetsServer{stream})
+}
+
+type BudgetService_ExportBudgetsServer interface {
+	Send(*CsvChunk) error
+	grpc.ServerStream
+}
+
+type budgetServiceExportBudgetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *budgetServiceExportBudgetsServer) Send(m *CsvChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BudgetService_ImportBudgets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BudgetServiceServer).ImportBudgets(&budgetServiceImportBudgetsServer{stream})
+}
+
+type BudgetService_ImportBudgetsServer interface {
+	SendAndClose(*ImportBudgetsResponse) error
+	Recv() (*ImportBudgetsRequest, error)
+	grpc.ServerStream
+}
+
+type budgetServiceImportBudgetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *budgetServiceImportBudgetsServer) SendAndClose(m *ImportBudgetsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *budgetServiceImportBudgetsServer) Recv() (*ImportBudgetsRequest, error) {
+	m := new(ImportBudgetsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BudgetService_CloneBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CloneBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_CloneBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CloneBudget(ctx, req.(*CloneBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_SaveBudgetAsTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBudgetAsTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).SaveBudgetAsTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_SaveBudgetAsTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).SaveBudgetAsTemplate(ctx, req.(*SaveBudgetAsTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_AddBudgetFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBudgetFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).AddBudgetFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetService_AddBudgetFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).AddBudgetFromTemplate(ctx, req.(*AddBudgetFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BudgetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "budget.BudgetService",
+	HandlerType: (*BudgetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddBudget",
+			Handler:    _BudgetService_AddBudget_Handler,
+		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _BudgetService_AddCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
 			Handler:    _BudgetService_UpdateCategory_Handler,
 		},
 		{
@@ -353,6 +1296,10 @@ var BudgetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBudgetList",
 			Handler:    _BudgetService_GetBudgetList_Handler,
 		},
+		{
+			MethodName: "GetBudgetSummary",
+			Handler:    _BudgetService_GetBudgetSummary_Handler,
+		},
 		{
 			MethodName: "UpdateBudget",
 			Handler:    _BudgetService_UpdateBudget_Handler,
@@ -361,7 +1308,111 @@ var BudgetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBudget",
 			Handler:    _BudgetService_DeleteBudget_Handler,
 		},
+		{
+			MethodName: "AddExpense",
+			Handler:    _BudgetService_AddExpense_Handler,
+		},
+		{
+			MethodName: "ListExpenses",
+			Handler:    _BudgetService_ListExpenses_Handler,
+		},
+		{
+			MethodName: "DeleteExpense",
+			Handler:    _BudgetService_DeleteExpense_Handler,
+		},
+		{
+			MethodName: "ListBudgetSeries",
+			Handler:    _BudgetService_ListBudgetSeries_Handler,
+		},
+		{
+			MethodName: "StopRecurrence",
+			Handler:    _BudgetService_StopRecurrence_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _BudgetService_ListDeleted_Handler,
+		},
+		{
+			MethodName: "RestoreBudget",
+			Handler:    _BudgetService_RestoreBudget_Handler,
+		},
+		{
+			MethodName: "RestoreCategory",
+			Handler:    _BudgetService_RestoreCategory_Handler,
+		},
+		{
+			MethodName: "GetBudgetHistory",
+			Handler:    _BudgetService_GetBudgetHistory_Handler,
+		},
+		{
+			MethodName: "ShareBudget",
+			Handler:    _BudgetService_ShareBudget_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _BudgetService_RevokeShare_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _BudgetService_ListMembers_Handler,
+		},
+		{
+			MethodName: "BackupUserData",
+			Handler:    _BudgetService_BackupUserData_Handler,
+		},
+		{
+			MethodName: "RestoreUserData",
+			Handler:    _BudgetService_RestoreUserData_Handler,
+		},
+		{
+			MethodName: "CloneBudget",
+			Handler:    _BudgetService_CloneBudget_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _BudgetService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _BudgetService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _BudgetService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _BudgetService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _BudgetService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "SaveBudgetAsTemplate",
+			Handler:    _BudgetService_SaveBudgetAsTemplate_Handler,
+		},
+		{
+			MethodName: "AddBudgetFromTemplate",
+			Handler:    _BudgetService_AddBudgetFromTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBudgets",
+			Handler:       _BudgetService_WatchBudgets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportBudgets",
+			Handler:       _BudgetService_ExportBudgets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBudgets",
+			Handler:       _BudgetService_ImportBudgets_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "budget/budget.proto",
 }